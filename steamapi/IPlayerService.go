@@ -173,3 +173,242 @@ type BadgeResponse struct {
 	Scarcity        int             `json:"scarcity"`
 	XP              int             `json:"xp"`
 }
+
+// Gets the quests completed for a community badge
+func (c *Client) GetCommunityBadgeProgress(playerID int64, badgeID int) (quests []BadgeQuest, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	options.Set("badgeid", strconv.Itoa(badgeID))
+
+	b, err := c.getFromAPI("IPlayerService/GetCommunityBadgeProgress/v1", options, true)
+	if err != nil {
+		return quests, err
+	}
+
+	var resp CommunityBadgeProgressResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return quests, err
+	}
+
+	return resp.Response.Quests, nil
+}
+
+type CommunityBadgeProgressResponse struct {
+	Response struct {
+		Quests []BadgeQuest `json:"quests"`
+	} `json:"response"`
+}
+
+type BadgeQuest struct {
+	QuestID   int  `json:"questid"`
+	Completed bool `json:"completed"`
+}
+
+// Returns the lender's ID if the player is playing a borrowed game, otherwise 0
+func (c *Client) IsPlayingSharedGame(playerID int64, appID int) (lenderID int64, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	options.Set("appid_playing", strconv.Itoa(appID))
+
+	b, err := c.getFromAPI("IPlayerService/IsPlayingSharedGame/v1", options, true)
+	if err != nil {
+		return lenderID, err
+	}
+
+	var resp SharedGameResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return lenderID, err
+	}
+
+	return int64(resp.Response.LenderSteamID), nil
+}
+
+type SharedGameResponse struct {
+	Response struct {
+		LenderSteamID unmarshal.Int64 `json:"lender_steamid"`
+	} `json:"response"`
+}
+
+// Gets the player's playtime for a single game
+func (c *Client) GetSingleGamePlaytime(playerID int64, appID int) (playtime SingleGamePlaytime, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	options.Set("appid", strconv.Itoa(appID))
+
+	b, err := c.getFromAPI("IPlayerService/GetSingleGamePlaytime/v1", options, true)
+	if err != nil {
+		return playtime, err
+	}
+
+	var resp SingleGamePlaytimeResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return playtime, err
+	}
+
+	return resp.Response, nil
+}
+
+type SingleGamePlaytimeResponse struct {
+	Response SingleGamePlaytime `json:"response"`
+}
+
+type SingleGamePlaytime struct {
+	PlaytimeForever int `json:"playtime_forever"`
+	Playtime2Weeks  int `json:"playtime_2weeks"`
+}
+
+// Gets the community items a player has equipped on their profile
+func (c *Client) GetProfileItemsEquipped(playerID int64, language LanguageCode) (items ProfileItemsEquipped, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	if language != "" {
+		options.Set("language", string(language))
+	}
+
+	b, err := c.getFromAPI("IPlayerService/GetProfileItemsEquipped/v1", options, true)
+	if err != nil {
+		return items, err
+	}
+
+	var resp ProfileItemsEquippedResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return items, err
+	}
+
+	return resp.Response, nil
+}
+
+type ProfileItemsEquippedResponse struct {
+	Response ProfileItemsEquipped `json:"response"`
+}
+
+type ProfileItemsEquipped struct {
+	ProfileBackground     ProfileItem `json:"profile_background"`
+	MiniProfileBackground ProfileItem `json:"mini_profile_background"`
+	AvatarFrame           ProfileItem `json:"avatar_frame"`
+	AnimatedAvatar        ProfileItem `json:"animated_avatar"`
+	ProfileModifier       ProfileItem `json:"profile_modifier"`
+	SteamDeckKeyboardSkin ProfileItem `json:"steam_deck_keyboard_skin"`
+}
+
+type ProfileItem struct {
+	CommunityItemID unmarshal.Int64 `json:"communityitemid"`
+	ImageSmall      string          `json:"image_small"`
+	ImageLarge      string          `json:"image_large"`
+	Name            string          `json:"name"`
+	ItemTitle       string          `json:"item_title"`
+	ItemDescription string          `json:"item_description"`
+	AppID           int             `json:"appid"`
+	ItemType        int             `json:"item_type"`
+	ItemClass       int             `json:"item_class"`
+	MovieWebm       string          `json:"movie_webm"`
+	MovieMP4        string          `json:"movie_mp4"`
+	MovieWebmSmall  string          `json:"movie_webm_small"`
+	MovieMP4Small   string          `json:"movie_mp4_small"`
+	EquippedFlags   int             `json:"equipped_flags"`
+}
+
+// Returns false if the item is empty, Steam returns an empty object when nothing is equipped
+func (i ProfileItem) IsEquipped() bool {
+	return i.CommunityItemID > 0
+}
+
+// Gets the animated avatar a player has equipped
+func (c *Client) GetAnimatedAvatar(playerID int64, language LanguageCode) (avatar ProfileItem, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	if language != "" {
+		options.Set("language", string(language))
+	}
+
+	b, err := c.getFromAPI("IPlayerService/GetAnimatedAvatar/v1", options, true)
+	if err != nil {
+		return avatar, err
+	}
+
+	var resp AnimatedAvatarResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return avatar, err
+	}
+
+	return resp.Response.Avatar, nil
+}
+
+type AnimatedAvatarResponse struct {
+	Response struct {
+		Avatar ProfileItem `json:"avatar"`
+	} `json:"response"`
+}
+
+// Gets the profile background a player has equipped
+func (c *Client) GetProfileBackground(playerID int64, language LanguageCode) (background ProfileItem, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	if language != "" {
+		options.Set("language", string(language))
+	}
+
+	b, err := c.getFromAPI("IPlayerService/GetProfileBackground/v1", options, true)
+	if err != nil {
+		return background, err
+	}
+
+	var resp ProfileBackgroundResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return background, err
+	}
+
+	return resp.Response.ProfileBackground, nil
+}
+
+type ProfileBackgroundResponse struct {
+	Response struct {
+		ProfileBackground ProfileItem `json:"profile_background"`
+	} `json:"response"`
+}
+
+// Gets the badge a player has chosen to show on their profile
+func (c *Client) GetFavoriteBadge(playerID int64) (badge FavoriteBadge, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+
+	b, err := c.getFromAPI("IPlayerService/GetFavoriteBadge/v1", options, true)
+	if err != nil {
+		return badge, err
+	}
+
+	var resp FavoriteBadgeResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return badge, err
+	}
+
+	return resp.Response, nil
+}
+
+type FavoriteBadgeResponse struct {
+	Response FavoriteBadge `json:"response"`
+}
+
+type FavoriteBadge struct {
+	HasFavoriteBadge bool            `json:"has_favorite_badge"`
+	BadgeID          int             `json:"badgeid"`
+	CommunityItemID  unmarshal.Int64 `json:"communityitemid"`
+	ItemType         int             `json:"item_type"`
+	BorderColor      int             `json:"border_color"`
+	AppID            int             `json:"appid"`
+	Level            int             `json:"level"`
+}
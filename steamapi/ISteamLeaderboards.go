@@ -0,0 +1,99 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/Jleagle/unmarshal-go"
+)
+
+// Needs a publisher key
+func (c *Client) GetLeaderboardsForGame(appID int) (leaderboards []PublisherLeaderboard, err error) {
+
+	options := url.Values{}
+	options.Set("appid", strconv.Itoa(appID))
+
+	b, err := c.getFromPartnerAPI("ISteamLeaderboards/GetLeaderboardsForGame/v2", options)
+	if err != nil {
+		return leaderboards, err
+	}
+
+	var resp LeaderboardsForGameResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return leaderboards, err
+	}
+
+	return resp.Response.Leaderboards, nil
+}
+
+type LeaderboardsForGameResponse struct {
+	Response struct {
+		Result       int                    `json:"result"`
+		Leaderboards []PublisherLeaderboard `json:"leaderboards"`
+	} `json:"response"`
+}
+
+type PublisherLeaderboard struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	Entries           int    `json:"entries"`
+	SortMethod        string `json:"sortmethod"`
+	DisplayType       string `json:"displaytype"`
+	OnlyTrustedWrites bool   `json:"onlytrustedwrites"`
+	OnlyFriendsReads  bool   `json:"onlyfriendsreads"`
+}
+
+type LeaderboardRequest int
+
+// noinspection GoUnusedConst
+const (
+	LeaderboardRequestGlobal     LeaderboardRequest = 0
+	LeaderboardRequestAroundUser LeaderboardRequest = 1 // Range is relative to the user, eg -5 to 5
+	LeaderboardRequestFriends    LeaderboardRequest = 2 // Range is ignored
+)
+
+// Needs a publisher key, playerID is only used for around user and friends requests
+func (c *Client) GetLeaderboardEntries(appID int, leaderboardID int, request LeaderboardRequest, playerID int64, start int, end int) (entries LeaderboardEntries, err error) {
+
+	options := url.Values{}
+	options.Set("appid", strconv.Itoa(appID))
+	options.Set("leaderboardid", strconv.Itoa(leaderboardID))
+	options.Set("datarequest", strconv.Itoa(int(request)))
+	options.Set("rangestart", strconv.Itoa(start))
+	options.Set("rangeend", strconv.Itoa(end))
+	if playerID > 0 {
+		options.Set("steamid", strconv.FormatInt(playerID, 10))
+	}
+
+	b, err := c.getFromPartnerAPI("ISteamLeaderboards/GetLeaderboardEntries/v1", options)
+	if err != nil {
+		return entries, err
+	}
+
+	var resp LeaderboardEntriesResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return entries, err
+	}
+
+	return resp.LeaderboardEntryInformation, nil
+}
+
+type LeaderboardEntriesResponse struct {
+	LeaderboardEntryInformation LeaderboardEntries `json:"leaderboardEntryInformation"`
+}
+
+type LeaderboardEntries struct {
+	AppID         int `json:"appID"`
+	LeaderboardID int `json:"leaderboardID"`
+	TotalCount    int `json:"totalLeaderBoardEntryCount"`
+	Entries       []struct {
+		SteamID    unmarshal.Int64 `json:"steamID"`
+		Score      int             `json:"score"`
+		Rank       int             `json:"rank"`
+		UGCID      unmarshal.Int64 `json:"ugcid"`
+		DetailData string          `json:"detailData"`
+	} `json:"leaderboardEntries"`
+}
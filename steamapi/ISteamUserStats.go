@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/Jleagle/unmarshal-go"
 )
@@ -126,12 +127,13 @@ type SchemaForGameStat struct {
 	DisplayName  string `json:"displayName"`
 }
 
-func (c *Client) GetPlayerAchievements(playerID uint64, appID uint32) (schema PlayerAchievementsResponse, err error) {
+// GetPlayerAchievements gets a player's achievements for the specified game, with names in the given language.
+func (c *Client) GetPlayerAchievements(playerID int64, appID int, language LanguageCode) (schema PlayerAchievementsResponse, err error) {
 
 	options := url.Values{}
-	options.Set("steamid", strconv.FormatUint(playerID, 10))
-	options.Set("appid", strconv.FormatUint(uint64(appID), 10))
-	options.Set("l", string(LanguageEnglish))
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	options.Set("appid", strconv.Itoa(appID))
+	options.Set("l", string(language))
 
 	b, err := c.getFromAPI("ISteamUserStats/GetPlayerAchievements/v1", options, true)
 	if err != nil {
@@ -147,6 +149,59 @@ func (c *Client) GetPlayerAchievements(playerID uint64, appID uint32) (schema Pl
 	return resp.Playerstats, nil
 }
 
+// GetSchemaTranslations gets a game's schema in every language in LanguageCodes,
+// one call at a time so the API rate limit is respected.
+func (c *Client) GetSchemaTranslations(appID int) (translations AchievementTranslations, err error) {
+
+	translations = AchievementTranslations{}
+
+	for _, language := range LanguageCodes {
+
+		schema, err := c.GetSchemaForGame(appID, language)
+		if err != nil {
+			return translations, err
+		}
+
+		for _, v := range schema.AvailableGameStats.Achievements {
+
+			if _, ok := translations[v.Name]; !ok {
+				translations[v.Name] = map[LanguageCode]AchievementTranslation{}
+			}
+
+			translations[v.Name][language] = AchievementTranslation{
+				DisplayName: v.DisplayName,
+				Description: v.Description,
+			}
+		}
+	}
+
+	return translations, nil
+}
+
+// Achievement API name -> language -> text
+type AchievementTranslations map[string]map[LanguageCode]AchievementTranslation
+
+type AchievementTranslation struct {
+	DisplayName string
+	Description string
+}
+
+// Get returns the text for an achievement in a language, falling back to English.
+func (t AchievementTranslations) Get(apiName string, language LanguageCode) (translation AchievementTranslation, ok bool) {
+
+	languages, ok := t[apiName]
+	if !ok {
+		return translation, false
+	}
+
+	if translation, ok = languages[language]; ok {
+		return translation, true
+	}
+
+	translation, ok = languages[LanguageEnglish]
+	return translation, ok
+}
+
 type PlayerAchievementsOuterResponse struct {
 	Playerstats PlayerAchievementsResponse `json:"playerstats"`
 }
@@ -164,3 +219,164 @@ type PlayerAchievementsResponse struct {
 	Error   string `json:"error"`
 	Success bool   `json:"success"`
 }
+
+// GetUserStatsForGame gets the stats and achievements a player has for the specified game.
+func (c *Client) GetUserStatsForGame(playerID int64, appID int) (stats UserStatsForGame, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+	options.Set("appid", strconv.Itoa(appID))
+
+	b, err := c.getFromAPI("ISteamUserStats/GetUserStatsForGame/v2", options, true)
+	if err != nil {
+		return stats, err
+	}
+
+	var resp UserStatsForGameResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return stats, err
+	}
+
+	return resp.PlayerStats, nil
+}
+
+type UserStatsForGameResponse struct {
+	PlayerStats UserStatsForGame `json:"playerstats"`
+}
+
+type UserStatsForGame struct {
+	SteamID  unmarshal.Int64 `json:"steamID"`
+	GameName string          `json:"gameName"`
+	Stats    []struct {
+		Name  string            `json:"name"`
+		Value unmarshal.Float64 `json:"value"`
+	} `json:"stats"`
+	Achievements []struct {
+		Name     string         `json:"name"`
+		Achieved unmarshal.Bool `json:"achieved"`
+	} `json:"achievements"`
+}
+
+func (s UserStatsForGame) GetMap() map[string]float64 {
+	m := map[string]float64{}
+	for _, v := range s.Stats {
+		m[v.Name] = float64(v.Value)
+	}
+	return m
+}
+
+// GetGlobalStatsForGame gets the aggregated values of the named stats across all players.
+// Pass zero times to get all time totals, or a range to also get the daily history.
+func (c *Client) GetGlobalStatsForGame(appID int, names []string, start time.Time, end time.Time) (stats GlobalStatsForGame, err error) {
+
+	options := url.Values{}
+	options.Set("appid", strconv.Itoa(appID))
+	options.Set("count", strconv.Itoa(len(names)))
+	for k, name := range names {
+		options.Set("name["+strconv.Itoa(k)+"]", name)
+	}
+	if !start.IsZero() {
+		options.Set("startdate", strconv.FormatInt(start.Unix(), 10))
+	}
+	if !end.IsZero() {
+		options.Set("enddate", strconv.FormatInt(end.Unix(), 10))
+	}
+
+	b, err := c.getFromAPI("ISteamUserStats/GetGlobalStatsForGame/v1", options, false)
+	if err != nil {
+		return stats, err
+	}
+
+	var resp GlobalStatsForGameResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return stats, err
+	}
+
+	if resp.Response.Result != 1 {
+		return stats, Error{Err: resp.Response.Error, Code: resp.Response.Result, URL: "ISteamUserStats/GetGlobalStatsForGame/v1"}
+	}
+
+	return resp.Response.GlobalStats, nil
+}
+
+type GlobalStatsForGameResponse struct {
+	Response struct {
+		GlobalStats GlobalStatsForGame `json:"globalstats"`
+		Result      int                `json:"result"`
+		Error       string             `json:"error"`
+	} `json:"response"`
+}
+
+type GlobalStatsForGame map[string]GlobalStat
+
+type GlobalStat struct {
+	Total   unmarshal.Int64 `json:"total"`
+	History []struct {
+		Date  int64           `json:"date"`
+		Total unmarshal.Int64 `json:"total"`
+	} `json:"history"`
+}
+
+// GetPlayerStats gets a player's stats for a game, labelled using the game's schema.
+func (c *Client) GetPlayerStats(playerID int64, appID int, language LanguageCode) (stats []PlayerStat, err error) {
+
+	schema, err := c.GetSchemaForGame(appID, language)
+	if err != nil {
+		return stats, err
+	}
+
+	userStats, err := c.GetUserStatsForGame(playerID, appID)
+	if err != nil {
+		return stats, err
+	}
+
+	return MergePlayerStats(schema, userStats), nil
+}
+
+type PlayerStat struct {
+	Name         string
+	DisplayName  string
+	DefaultValue int
+	Value        float64
+	HasValue     bool // False if the player has no value and Value is the default
+}
+
+// MergePlayerStats joins a player's stat values with the stats in a schema.
+// Every schema stat is returned in schema order, stats missing from the schema are appended.
+func MergePlayerStats(schema SchemaForGame, userStats UserStatsForGame) (stats []PlayerStat) {
+
+	values := userStats.GetMap()
+	seen := map[string]bool{}
+
+	for _, v := range schema.AvailableGameStats.Stats {
+
+		stat := PlayerStat{
+			Name:         v.Name,
+			DisplayName:  v.DisplayName,
+			DefaultValue: v.DefaultValue,
+			Value:        float64(v.DefaultValue),
+		}
+
+		if val, ok := values[v.Name]; ok {
+			stat.Value = val
+			stat.HasValue = true
+		}
+
+		if stat.DisplayName == "" {
+			stat.DisplayName = v.Name
+		}
+
+		seen[v.Name] = true
+		stats = append(stats, stat)
+	}
+
+	for _, v := range userStats.Stats {
+		if !seen[v.Name] {
+			stats = append(stats, PlayerStat{Name: v.Name, DisplayName: v.Name, Value: float64(v.Value), HasValue: true})
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,45 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMergePlayerStats(t *testing.T) {
+
+	var schema SchemaForGame
+	err := json.Unmarshal([]byte(`{"gameName":"Test","availableGameStats":{"stats":[
+		{"name":"kills","defaultvalue":0,"displayName":"Kills"},
+		{"name":"deaths","defaultvalue":0,"displayName":"Deaths"},
+		{"name":"wins","defaultvalue":5,"displayName":""}
+	]}}`), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var userStats UserStatsForGame
+	err = json.Unmarshal([]byte(`{"steamID":"76561197968626192","gameName":"Test","stats":[
+		{"name":"kills","value":12},
+		{"name":"accuracy","value":0.5}
+	]}`), &userStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats := MergePlayerStats(schema, userStats)
+	if len(stats) != 4 {
+		t.Fatal("length", len(stats))
+	}
+	if stats[0].DisplayName != "Kills" || stats[0].Value != 12 || !stats[0].HasValue {
+		t.Error("kills", stats[0])
+	}
+	if stats[1].Value != 0 || stats[1].HasValue {
+		t.Error("deaths", stats[1])
+	}
+	if stats[2].DisplayName != "wins" || stats[2].Value != 5 {
+		t.Error("wins", stats[2])
+	}
+	if stats[3].Name != "accuracy" || stats[3].Value != 0.5 {
+		t.Error("accuracy", stats[3])
+	}
+}
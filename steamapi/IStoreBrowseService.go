@@ -0,0 +1,165 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/Jleagle/unmarshal-go"
+)
+
+// Everything is left out of the response unless requested
+type StoreBrowseDataRequest struct {
+	IncludeAssets             bool `json:"include_assets,omitempty"`
+	IncludeRelease            bool `json:"include_release,omitempty"`
+	IncludePlatforms          bool `json:"include_platforms,omitempty"`
+	IncludeAllPurchaseOptions bool `json:"include_all_purchase_options,omitempty"`
+	IncludeScreenshots        bool `json:"include_screenshots,omitempty"`
+	IncludeTrailers           bool `json:"include_trailers,omitempty"`
+	IncludeRatings            bool `json:"include_ratings,omitempty"`
+	IncludeTagCount           int  `json:"include_tag_count,omitempty"`
+	IncludeReviews            bool `json:"include_reviews,omitempty"`
+	IncludeBasicInfo          bool `json:"include_basic_info,omitempty"`
+}
+
+type StoreBrowseID struct {
+	AppID     int `json:"appid,omitempty"`
+	PackageID int `json:"packageid,omitempty"`
+	BundleID  int `json:"bundleid,omitempty"`
+	TagID     int `json:"tagid,omitempty"`
+	CreatorID int `json:"creatorid,omitempty"`
+	HubID     int `json:"hubcategoryid,omitempty"`
+}
+
+func (c *Client) GetItems(ids []StoreBrowseID, cc ProductCC, language LanguageCode, request StoreBrowseDataRequest) (items []StoreItem, err error) {
+
+	input := struct {
+		IDs     []StoreBrowseID `json:"ids"`
+		Context struct {
+			Language    LanguageCode `json:"language"`
+			CountryCode string       `json:"country_code"`
+		} `json:"context"`
+		DataRequest StoreBrowseDataRequest `json:"data_request"`
+	}{
+		IDs:         ids,
+		DataRequest: request,
+	}
+	input.Context.Language = language
+	input.Context.CountryCode = strings.ToUpper(string(cc))
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		return items, err
+	}
+
+	options := url.Values{}
+	options.Set("input_json", string(b))
+
+	b, err = c.getFromAPI("IStoreBrowseService/GetItems/v1", options, false)
+	if err != nil {
+		return items, err
+	}
+
+	var resp StoreItemsResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return items, err
+	}
+
+	return resp.Response.StoreItems, nil
+}
+
+type StoreItemsResponse struct {
+	Response struct {
+		StoreItems []StoreItem `json:"store_items"`
+	} `json:"response"`
+}
+
+type StoreItem struct {
+	ItemType           int                       `json:"item_type"` // 0 app, 1 package, 2 bundle
+	ID                 int                       `json:"id"`
+	Success            int                       `json:"success"`
+	Visible            bool                      `json:"visible"`
+	Name               string                    `json:"name"`
+	StoreURLPath       string                    `json:"store_url_path"`
+	AppID              int                       `json:"appid"`
+	Type               int                       `json:"type"`
+	IsFree             bool                      `json:"is_free"`
+	IsEarlyAccess      bool                      `json:"is_early_access"`
+	TagIDs             []int                     `json:"tagids"`
+	Tags               []StoreItemTag            `json:"tags"`
+	BasicInfo          StoreItemBasicInfo        `json:"basic_info"`
+	Assets             StoreItemAssets           `json:"assets"`
+	Release            StoreItemRelease          `json:"release"`
+	Platforms          StoreItemPlatforms        `json:"platforms"`
+	BestPurchaseOption StoreItemPurchaseOption   `json:"best_purchase_option"`
+	PurchaseOptions    []StoreItemPurchaseOption `json:"purchase_options"`
+}
+
+type StoreItemTag struct {
+	TagID  int `json:"tagid"`
+	Weight int `json:"weight"`
+}
+
+type StoreItemBasicInfo struct {
+	ShortDescription string `json:"short_description"`
+	Publishers       []struct {
+		Name string `json:"name"`
+	} `json:"publishers"`
+	Developers []struct {
+		Name string `json:"name"`
+	} `json:"developers"`
+	CapsuleHeadline string `json:"capsule_headline"`
+}
+
+type StoreItemAssets struct {
+	AssetURLFormat   string `json:"asset_url_format"` // eg "steam/apps/440/${FILENAME}?t=1"
+	MainCapsule      string `json:"main_capsule"`
+	SmallCapsule     string `json:"small_capsule"`
+	Header           string `json:"header"`
+	PackageHeader    string `json:"package_header"`
+	PageBackground   string `json:"page_background"`
+	HeroCapsule      string `json:"hero_capsule"`
+	HeroCapsule2x    string `json:"hero_capsule_2x"`
+	LibraryCapsule   string `json:"library_capsule"`
+	LibraryCapsule2x string `json:"library_capsule_2x"`
+	LibraryHero      string `json:"library_hero"`
+	LibraryHero2x    string `json:"library_hero_2x"`
+	CommunityIcon    string `json:"community_icon"`
+}
+
+// GetURL returns the path of an asset filename on the CDN, eg "steam/apps/440/header.jpg?t=1"
+func (a StoreItemAssets) GetURL(filename string) string {
+	if filename == "" {
+		return ""
+	}
+	return strings.Replace(a.AssetURLFormat, "${FILENAME}", filename, 1)
+}
+
+type StoreItemRelease struct {
+	SteamReleaseDate    int64 `json:"steam_release_date"`
+	OriginalReleaseDate int64 `json:"original_release_date"`
+	IsComingSoon        bool  `json:"is_coming_soon"`
+	IsPreload           bool  `json:"is_preload"`
+	IsEarlyAccess       bool  `json:"is_early_access"`
+}
+
+type StoreItemPlatforms struct {
+	Windows                 bool         `json:"windows"`
+	Mac                     bool         `json:"mac"`
+	SteamOSLinux            bool         `json:"steamos_linux"`
+	SteamDeckCompatCategory DeckCategory `json:"steam_deck_compat_category"`
+}
+
+type StoreItemPurchaseOption struct {
+	PackageID              int           `json:"packageid"`
+	BundleID               int           `json:"bundleid"`
+	PurchaseOptionName     string        `json:"purchase_option_name"`
+	FinalPriceInCents      unmarshal.Int `json:"final_price_in_cents"`
+	OriginalPriceInCents   unmarshal.Int `json:"original_price_in_cents"`
+	FormattedFinalPrice    string        `json:"formatted_final_price"`
+	FormattedOriginalPrice string        `json:"formatted_original_price"`
+	DiscountPct            int           `json:"discount_pct"`
+	BundleDiscountPct      int           `json:"bundle_discount_pct"`
+	IsFreeToKeep           bool          `json:"is_free_to_keep"`
+}
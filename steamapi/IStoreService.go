@@ -8,26 +8,57 @@ import (
 
 func (c *Client) GetAppList(limit int, offset int, afterDate int64, language LanguageCode) (apps AppList, err error) {
 
+	options := AppListOptions{
+		PageSize:      limit,
+		ModifiedSince: afterDate,
+		Language:      language,
+	}
+
+	return c.GetAppListPage(options, offset)
+}
+
+// Types are included unless excluded, so the zero value lists every app
+type AppListOptions struct {
+	ExcludeGames    bool
+	ExcludeDLC      bool
+	ExcludeSoftware bool
+	ExcludeVideos   bool
+	ExcludeHardware bool
+	ModifiedSince   int64 // Unix time, only list apps changed after this
+	Language        LanguageCode
+	PageSize        int // Steam defaults to 10,000 and allows up to 50,000
+}
+
+// GetAppListPage gets the page of apps after lastAppID, use 0 for the first page
+func (c *Client) GetAppListPage(options AppListOptions, lastAppID int) (apps AppList, err error) {
+
+	include := func(exclude bool) string {
+		if exclude {
+			return "0"
+		}
+		return "1"
+	}
+
 	q := url.Values{}
-	q.Set("include_games", "1")
-	q.Set("include_dlc", "1")
-	q.Set("include_software", "1")
-	q.Set("include_videos", "1")
-	q.Set("include_hardware", "1")
+	q.Set("include_games", include(options.ExcludeGames))
+	q.Set("include_dlc", include(options.ExcludeDLC))
+	q.Set("include_software", include(options.ExcludeSoftware))
+	q.Set("include_videos", include(options.ExcludeVideos))
+	q.Set("include_hardware", include(options.ExcludeHardware))
 
-	if afterDate > 0 {
-		q.Set("if_modified_since", strconv.FormatInt(afterDate, 10))
+	if options.ModifiedSince > 0 {
+		q.Set("if_modified_since", strconv.FormatInt(options.ModifiedSince, 10))
 	}
 
-	if language != "" {
-		q.Set("have_description_language", string(language))
+	if options.Language != "" {
+		q.Set("have_description_language", string(options.Language))
 	}
 
-	if offset > 0 {
-		q.Set("last_appid", strconv.Itoa(offset))
+	if lastAppID > 0 {
+		q.Set("last_appid", strconv.Itoa(lastAppID))
 	}
-	if limit > 0 {
-		q.Set("max_results", strconv.Itoa(limit))
+	if options.PageSize > 0 {
+		q.Set("max_results", strconv.Itoa(options.PageSize))
 	}
 
 	b, err := c.getFromAPI("IStoreService/GetAppList/v1", q, true)
@@ -44,17 +75,45 @@ func (c *Client) GetAppList(limit int, offset int, afterDate int64, language Lan
 	return resp.AppListResponseInner, nil
 }
 
+// WalkAppList calls fn with every app in the catalog, paging until Steam has no more results or fn returns false
+func (c *Client) WalkAppList(options AppListOptions, fn func(app AppListApp) bool) error {
+
+	var lastAppID int
+
+	for {
+		apps, err := c.GetAppListPage(options, lastAppID)
+		if err != nil {
+			return err
+		}
+
+		for _, app := range apps.Apps {
+			if !fn(app) {
+				return nil
+			}
+		}
+
+		// Stop if Steam does not move the cursor on, to avoid looping forever
+		if !apps.HaveMoreResults || apps.LastAppID <= lastAppID {
+			return nil
+		}
+
+		lastAppID = apps.LastAppID
+	}
+}
+
 type AppListResponse struct {
 	AppListResponseInner AppList `json:"response"`
 }
 
 type AppList struct {
-	Apps []struct {
-		AppID             int    `json:"appid"`
-		Name              string `json:"name"`
-		LastModified      int64  `json:"last_modified"`
-		PriceChangeNumber int    `json:"price_change_number"`
-	} `json:"apps"`
-	HaveMoreResults bool `json:"have_more_results"`
-	LastAppID       int  `json:"last_appid"`
+	Apps            []AppListApp `json:"apps"`
+	HaveMoreResults bool         `json:"have_more_results"`
+	LastAppID       int          `json:"last_appid"`
+}
+
+type AppListApp struct {
+	AppID             int    `json:"appid"`
+	Name              string `json:"name"`
+	LastModified      int64  `json:"last_modified"`
+	PriceChangeNumber int    `json:"price_change_number"`
 }
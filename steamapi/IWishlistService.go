@@ -0,0 +1,125 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// GetWishlist returns ErrWishlistNotFound if the wishlist is private.
+// Steam sends the same empty response for private and empty wishlists, so empty ones check
+// the profile visibility, which needs an API key.
+func (c *Client) GetWishlist(playerID int64) (items []WishlistItem, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+
+	b, err := c.getFromAPI("IWishlistService/GetWishlist/v1", options, false)
+	if err != nil {
+		return items, err
+	}
+
+	var resp WishlistResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return items, err
+	}
+
+	if len(resp.Response.Items) == 0 {
+		if err = c.checkWishlistVisible(playerID); err != nil {
+			return items, err
+		}
+	}
+
+	return resp.Response.Items, nil
+}
+
+type WishlistResponse struct {
+	Response struct {
+		Items []WishlistItem `json:"items"`
+	} `json:"response"`
+}
+
+type WishlistItem struct {
+	AppID     int          `json:"appid"`
+	Priority  int          `json:"priority"` // 0 is unranked
+	DateAdded int64        `json:"date_added"`
+	Price     *RegionPrice `json:"-"` // Set by EnrichWishlist, nil if free or not for sale
+}
+
+func (i WishlistItem) GetDateAdded() time.Time {
+	return time.Unix(i.DateAdded, 0)
+}
+
+// GetWishlistItemCount returns ErrWishlistNotFound if the wishlist is private, see GetWishlist
+func (c *Client) GetWishlistItemCount(playerID int64) (count int, err error) {
+
+	options := url.Values{}
+	options.Set("steamid", strconv.FormatInt(playerID, 10))
+
+	b, err := c.getFromAPI("IWishlistService/GetWishlistItemCount/v1", options, false)
+	if err != nil {
+		return count, err
+	}
+
+	var resp WishlistItemCountResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return count, err
+	}
+
+	if resp.Response.Count == 0 {
+		if err = c.checkWishlistVisible(playerID); err != nil {
+			return count, err
+		}
+	}
+
+	return resp.Response.Count, nil
+}
+
+// Wishlists are only visible on public profiles
+func (c *Client) checkWishlistVisible(playerID int64) error {
+
+	player, err := c.GetPlayer(playerID)
+	if err == ErrProfileMissing {
+		return ErrWishlistNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if player.CommunityVisibilityState != 3 {
+		return ErrWishlistNotFound
+	}
+
+	return nil
+}
+
+type WishlistItemCountResponse struct {
+	Response struct {
+		Count int `json:"count"`
+	} `json:"response"`
+}
+
+// EnrichWishlist sets the price of each item in a region, using batched app details calls
+func (c *Client) EnrichWishlist(items []WishlistItem, cc ProductCC) error {
+
+	var ids []uint
+	for _, v := range items {
+		ids = append(ids, uint(v.AppID))
+	}
+
+	matrix, err := c.GetPriceMatrix(ids, []ProductCC{cc})
+	if err != nil {
+		return err
+	}
+
+	for k, v := range items {
+		if price, ok := matrix[uint(v.AppID)][cc]; ok {
+			items[k].Price = &price
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package steamapi
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// GetAchievementReport joins a game's achievement schema, global unlock percentages and a player's unlocks.
+// If the player's profile is private, the report is still returned with Private set and nothing unlocked.
+func (c *Client) GetAchievementReport(playerID int64, appID int, language LanguageCode) (report AchievementReport, err error) {
+
+	var (
+		wg          sync.WaitGroup
+		schema      SchemaForGame
+		percentages GlobalAchievementPercentages
+		player      PlayerAchievementsResponse
+
+		schemaErr, percentagesErr, playerErr error
+	)
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		schema, schemaErr = c.GetSchemaForGame(appID, language)
+	}()
+
+	go func() {
+		defer wg.Done()
+		percentages, percentagesErr = c.GetGlobalAchievementPercentagesForApp(appID)
+	}()
+
+	go func() {
+		defer wg.Done()
+		player, playerErr = c.GetPlayerAchievements(playerID, appID, language)
+	}()
+
+	wg.Wait()
+
+	if schemaErr != nil {
+		return report, schemaErr
+	}
+	if percentagesErr != nil {
+		return report, percentagesErr
+	}
+
+	if playerErr != nil {
+		var apiErr Error
+		if errors.As(playerErr, &apiErr) && apiErr.Code == http.StatusForbidden {
+			report.Private = true
+		} else {
+			return report, playerErr
+		}
+	} else if !player.Success && player.Error != "" {
+		report.Private = true
+	}
+
+	return MergeAchievementReport(appID, schema, percentages, player, report.Private), nil
+}
+
+// MergeAchievementReport joins the three achievement responses by API name, in schema order.
+func MergeAchievementReport(appID int, schema SchemaForGame, percentages GlobalAchievementPercentages, player PlayerAchievementsResponse, private bool) (report AchievementReport) {
+
+	report.AppID = appID
+	report.GameName = schema.Name
+	report.Private = private
+
+	global := percentages.GetMap()
+
+	type unlock struct {
+		achieved bool
+		time     int64
+	}
+
+	unlocks := map[string]unlock{}
+	if !private {
+		for _, v := range player.Achievements {
+			unlocks[v.APIName] = unlock{achieved: bool(v.Achieved), time: v.UnlockTime}
+		}
+	}
+
+	for _, v := range schema.AvailableGameStats.Achievements {
+
+		achievement := ReportAchievement{
+			APIName:     v.Name,
+			DisplayName: v.DisplayName,
+			Description: v.Description,
+			Icon:        v.Icon,
+			IconGray:    v.IconGray,
+			Hidden:      bool(v.Hidden),
+			Percent:     global[v.Name],
+		}
+
+		if u, ok := unlocks[v.Name]; ok && u.achieved {
+			achievement.Achieved = true
+			if u.time > 0 {
+				achievement.UnlockTime = time.Unix(u.time, 0)
+			}
+			report.Unlocked++
+		}
+
+		report.Achievements = append(report.Achievements, achievement)
+	}
+
+	if len(report.Achievements) > 0 {
+		report.CompletionPercent = float64(report.Unlocked) / float64(len(report.Achievements)) * 100
+	}
+
+	return report
+}
+
+type AchievementReport struct {
+	AppID             int
+	GameName          string
+	Private           bool // The player's achievements could not be read
+	Achievements      []ReportAchievement
+	Unlocked          int
+	CompletionPercent float64
+}
+
+type ReportAchievement struct {
+	APIName     string
+	DisplayName string
+	Description string
+	Icon        string
+	IconGray    string
+	Hidden      bool
+	Percent     float64 // Percentage of all players that have unlocked it
+	Achieved    bool
+	UnlockTime  time.Time
+}
+
+// GetRarestUnlocks returns the player's unlocked achievements with the lowest global percentages first.
+// Pass a limit of 0 to return them all.
+func (r AchievementReport) GetRarestUnlocks(limit int) (achievements []ReportAchievement) {
+
+	for _, v := range r.Achievements {
+		if v.Achieved {
+			achievements = append(achievements, v)
+		}
+	}
+
+	sort.SliceStable(achievements, func(i, j int) bool {
+		return achievements[i].Percent < achievements[j].Percent
+	})
+
+	if limit > 0 && len(achievements) > limit {
+		achievements = achievements[:limit]
+	}
+
+	return achievements
+}
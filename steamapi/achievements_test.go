@@ -0,0 +1,53 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMergeAchievementReport(t *testing.T) {
+
+	var schema SchemaForGame
+	err := json.Unmarshal([]byte(`{"gameName":"Test","availableGameStats":{"achievements":[
+		{"name":"A","displayName":"First","hidden":0},
+		{"name":"B","displayName":"Second","hidden":1},
+		{"name":"C","displayName":"Third","hidden":0}
+	]}}`), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var percentages GlobalAchievementPercentages
+	err = json.Unmarshal([]byte(`{"achievements":[{"name":"A","percent":80.5},{"name":"B","percent":1.2},{"name":"C","percent":40}]}`), &percentages)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var player PlayerAchievementsResponse
+	err = json.Unmarshal([]byte(`{"success":true,"achievements":[
+		{"apiname":"A","achieved":1,"unlocktime":1600000000},
+		{"apiname":"B","achieved":1,"unlocktime":1600000100},
+		{"apiname":"C","achieved":0,"unlocktime":0}
+	]}`), &player)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := MergeAchievementReport(10, schema, percentages, player, false)
+	if report.Unlocked != 2 || int(report.CompletionPercent) != 66 {
+		t.Error("completion", report.Unlocked, report.CompletionPercent)
+	}
+	if !report.Achievements[1].Hidden || report.Achievements[1].UnlockTime.Unix() != 1600000100 {
+		t.Error("second", report.Achievements[1])
+	}
+
+	rarest := report.GetRarestUnlocks(1)
+	if len(rarest) != 1 || rarest[0].APIName != "B" {
+		t.Error("rarest", rarest)
+	}
+
+	report = MergeAchievementReport(10, schema, percentages, player, true)
+	if report.Unlocked != 0 || report.Achievements[0].Percent != 80.5 {
+		t.Error("private", report)
+	}
+}
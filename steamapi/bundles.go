@@ -0,0 +1,166 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var ErrBundleNotFound = errors.New("steam: store: bundle not found")
+
+func (c *Client) GetBundleDetails(id uint, cc ProductCC, language LanguageCode) (bundle BundleDetails, err error) {
+
+	bundles, err := c.GetBundleDetailsMulti([]uint{id}, cc, language)
+	if err != nil {
+		return bundle, err
+	}
+
+	for _, v := range bundles {
+		if v.BundleID == int(id) {
+			return v, nil
+		}
+	}
+
+	return bundle, ErrBundleNotFound
+}
+
+// Bundles that do not exist are missing from the response
+func (c *Client) GetBundleDetailsMulti(ids []uint, cc ProductCC, language LanguageCode) (bundles []BundleDetails, err error) {
+
+	var stringIDs []string
+	for _, id := range ids {
+		stringIDs = append(stringIDs, strconv.FormatUint(uint64(id), 10))
+	}
+
+	query := url.Values{}
+	query.Set("bundleids", strings.Join(stringIDs, ","))
+	query.Set("cc", string(cc))
+	query.Set("l", string(language))
+
+	b, err := c.getFromStore("actions/ajaxresolvebundles", query)
+	if err != nil {
+		return bundles, err
+	}
+
+	if string(b) == "null" {
+		return bundles, ErrNullResponse
+	}
+	if strings.HasPrefix(string(b), "<") {
+		return bundles, ErrHTMLResponse
+	}
+
+	err = json.Unmarshal(b, &bundles)
+	return bundles, err
+}
+
+type BundleDetails struct {
+	BundleID            int          `json:"bundleid"`
+	Name                string       `json:"name"`
+	URL                 string       `json:"url"`
+	AvailableWindows    bool         `json:"available_windows"`
+	AvailableMac        bool         `json:"available_mac"`
+	AvailableLinux      bool         `json:"available_linux"`
+	SupportVRHMD        bool         `json:"support_vrhmd"`
+	SupportVRHMDOnly    bool         `json:"support_vrhmd_only"`
+	HeaderImageURL      string       `json:"header_image_url"`
+	MainCapsule         string       `json:"main_capsule"`
+	LibraryAsset        string       `json:"library_asset"`
+	AppIDs              []int        `json:"appids"`
+	PackageIDs          []int        `json:"packageids"`
+	DiscountPercent     int          `json:"discount_percent"`
+	BundleBaseDiscount  int          `json:"bundle_base_discount"`
+	InitialPrice        int          `json:"initial_price"`
+	FinalPrice          int          `json:"final_price"`
+	FormattedOrigPrice  string       `json:"formatted_orig_price"`
+	FormattedFinalPrice string       `json:"formatted_final_price"`
+	Currency            CurrencyCode `json:"currency"`
+	CreatorClanIDs      []int        `json:"creator_clan_ids"`
+	ComingSoon          bool         `json:"coming_soon"`
+	NoMainCap           bool         `json:"no_main_cap"`
+}
+
+type ExpandedApp struct {
+	AppID int
+	Name  string
+	Type  string // eg game or dlc, empty if the store has no details for the app
+}
+
+// ExpandPackage returns the apps a package grants
+func (c *Client) ExpandPackage(id uint, cc ProductCC, language LanguageCode) (apps []ExpandedApp, err error) {
+
+	pack, err := c.GetPackageDetails(id, cc, language)
+	if err != nil {
+		return apps, err
+	}
+
+	var ids []int
+	for _, v := range pack.Data.Apps {
+		ids = append(ids, v.ID)
+	}
+
+	return c.resolveAppTypes(ids, cc, language)
+}
+
+// ExpandBundle returns the apps a bundle grants, including the apps in its packages
+func (c *Client) ExpandBundle(id uint, cc ProductCC, language LanguageCode) (apps []ExpandedApp, err error) {
+
+	bundle, err := c.GetBundleDetails(id, cc, language)
+	if err != nil {
+		return apps, err
+	}
+
+	ids := append([]int(nil), bundle.AppIDs...)
+
+	if len(bundle.PackageIDs) > 0 {
+
+		var packageIDs []uint
+		for _, v := range bundle.PackageIDs {
+			packageIDs = append(packageIDs, uint(v))
+		}
+
+		packages, err := c.GetPackageDetailsMulti(packageIDs, cc, language)
+		if err != nil {
+			return apps, err
+		}
+
+		for _, v := range packages {
+			for _, app := range v.Data.Apps {
+				ids = append(ids, app.ID)
+			}
+		}
+	}
+
+	return c.resolveAppTypes(ids, cc, language)
+}
+
+// The store only returns more than one app per call when filtering to prices, so types need a call per app
+func (c *Client) resolveAppTypes(ids []int, cc ProductCC, language LanguageCode) (apps []ExpandedApp, err error) {
+
+	seen := map[int]bool{}
+
+	for _, id := range ids {
+
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		app := ExpandedApp{AppID: id}
+
+		details, err := c.GetAppDetails(uint(id), cc, language, []string{"basic"})
+		if err != nil && err != ErrAppNotFound {
+			return apps, err
+		}
+
+		if err == nil && details.Data != nil {
+			app.Name = details.Data.Name
+			app.Type = details.Data.Type
+		}
+
+		apps = append(apps, app)
+	}
+
+	return apps, nil
+}
@@ -0,0 +1,141 @@
+package steamapi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CDN builds URLs to Steam's images from the hashes and filenames in responses
+type CDN struct {
+	Store   string // App assets and community images
+	Avatars string
+	Economy string // Item images from icon_url fields
+}
+
+var DefaultCDN = CDN{
+	Store:   "https://cdn.akamai.steamstatic.com/",
+	Avatars: "https://avatars.akamai.steamstatic.com/",
+	Economy: "https://community.akamai.steamstatic.com/economy/image/",
+}
+
+// The "?" avatar players get when they have not set one
+const DefaultAvatarHash = "fef49e7fa7e1997310d705b2a6158ff8dc1cdfeb"
+
+type CapsuleSize string
+
+// noinspection GoUnusedConst
+const (
+	CapsuleSmall CapsuleSize = "capsule_sm_120"
+	Capsule184   CapsuleSize = "capsule_184x69"
+	Capsule231   CapsuleSize = "capsule_231x87"
+	Capsule467   CapsuleSize = "capsule_467x181"
+	CapsuleMain  CapsuleSize = "capsule_616x353"
+)
+
+type AvatarSize string
+
+// noinspection GoUnusedConst
+const (
+	AvatarSmall  AvatarSize = ""        // 32x32
+	AvatarMedium AvatarSize = "_medium" // 64x64
+	AvatarFull   AvatarSize = "_full"   // 184x184
+)
+
+func (c CDN) app(appID int, filename string, language LanguageCode, extension string) string {
+
+	if language != "" && language != LanguageEnglish {
+		filename += "_" + string(language)
+	}
+
+	return c.Store + "steam/apps/" + strconv.Itoa(appID) + "/" + filename + extension
+}
+
+// Localized assets are only there if the developer uploaded them, pass an empty language for the default
+func (c CDN) AppHeader(appID int, language LanguageCode) string {
+	return c.app(appID, "header", language, ".jpg")
+}
+
+func (c CDN) AppCapsule(appID int, size CapsuleSize, language LanguageCode) string {
+	return c.app(appID, string(size), language, ".jpg")
+}
+
+func (c CDN) AppPageBackground(appID int) string {
+	return c.app(appID, "page_bg_generated_v6b", "", ".jpg")
+}
+
+// The 600x900 portrait capsule in the library
+func (c CDN) LibraryCapsule(appID int, language LanguageCode, retina bool) string {
+	if retina {
+		return c.app(appID, "library_600x900_2x", language, ".jpg")
+	}
+	return c.app(appID, "library_600x900", language, ".jpg")
+}
+
+func (c CDN) LibraryHero(appID int, language LanguageCode, retina bool) string {
+	if retina {
+		return c.app(appID, "library_hero_2x", language, ".jpg")
+	}
+	return c.app(appID, "library_hero", language, ".jpg")
+}
+
+func (c CDN) LibraryLogo(appID int, language LanguageCode) string {
+	return c.app(appID, "logo", language, ".png")
+}
+
+// StoreAsset uses the asset_url_format from IStoreBrowseService, eg for hashed library assets
+func (c CDN) StoreAsset(assets StoreItemAssets, filename string) string {
+	path := assets.GetURL(filename)
+	if path == "" {
+		return ""
+	}
+	return c.Store + path
+}
+
+// CommunityIcon uses a hash like ImgIconURL or the icon and logo hashes in appinfo
+func (c CDN) CommunityIcon(appID int, hash string) string {
+	if hash == "" {
+		return ""
+	}
+	return c.Store + "steamcommunity/public/images/apps/" + strconv.Itoa(appID) + "/" + hash + ".jpg"
+}
+
+// ClientIcon uses the clienticon hash from appinfo
+func (c CDN) ClientIcon(appID int, hash string) string {
+	if hash == "" {
+		return ""
+	}
+	return c.Store + "steamcommunity/public/images/apps/" + strconv.Itoa(appID) + "/" + hash + ".ico"
+}
+
+func (c CDN) Avatar(hash string, size AvatarSize) string {
+	if hash == "" || strings.Trim(hash, "0") == "" {
+		hash = DefaultAvatarHash
+	}
+	return c.Avatars + hash + string(size) + ".jpg"
+}
+
+// EconomyImage uses an item's icon_url, pass 0 for the width and height to get the original size
+func (c CDN) EconomyImage(iconURL string, width int, height int) string {
+
+	if iconURL == "" {
+		return ""
+	}
+
+	u := c.Economy + iconURL
+	if width > 0 && height > 0 {
+		u += "/" + strconv.Itoa(width) + "fx" + strconv.Itoa(height) + "f"
+	}
+	return u
+}
+
+func (g RecentlyPlayedGame) GetIconURL() string {
+	return DefaultCDN.CommunityIcon(g.AppID, g.ImgIconURL)
+}
+
+func (g RecentlyPlayedGame) GetLogoURL() string {
+	return DefaultCDN.CommunityIcon(g.AppID, g.ImgLogoURL)
+}
+
+func (p PlayerSummary) GetAvatarURL(size AvatarSize) string {
+	return DefaultCDN.Avatar(p.AvatarHash, size)
+}
@@ -0,0 +1,30 @@
+package steamapi
+
+import (
+	"testing"
+)
+
+func TestCDN(t *testing.T) {
+
+	m := map[string]string{
+		DefaultCDN.AppHeader(440, LanguageEnglish):                                                             "https://cdn.akamai.steamstatic.com/steam/apps/440/header.jpg",
+		DefaultCDN.LibraryLogo(440, LanguageGerman):                                                            "https://cdn.akamai.steamstatic.com/steam/apps/440/logo_german.png",
+		DefaultCDN.LibraryCapsule(440, "", true):                                                               "https://cdn.akamai.steamstatic.com/steam/apps/440/library_600x900_2x.jpg",
+		DefaultCDN.CommunityIcon(440, "e3f595a92552da3d664ad00277fad2107345f743"):                              "https://cdn.akamai.steamstatic.com/steamcommunity/public/images/apps/440/e3f595a92552da3d664ad00277fad2107345f743.jpg",
+		DefaultCDN.Avatar("", AvatarFull):                                                                      "https://avatars.akamai.steamstatic.com/fef49e7fa7e1997310d705b2a6158ff8dc1cdfeb_full.jpg",
+		DefaultCDN.EconomyImage("abc", 96, 96):                                                                 "https://community.akamai.steamstatic.com/economy/image/abc/96fx96f",
+		DefaultCDN.StoreAsset(StoreItemAssets{AssetURLFormat: "steam/apps/440/${FILENAME}?t=1"}, "header.jpg"): "https://cdn.akamai.steamstatic.com/steam/apps/440/header.jpg?t=1",
+	}
+
+	for have, want := range m {
+		if have != want {
+			t.Error(have, want)
+		}
+	}
+
+	cdn := DefaultCDN
+	cdn.Store = "https://cdn.example.com/"
+	if cdn.AppHeader(10, "") != "https://cdn.example.com/steam/apps/10/header.jpg" {
+		t.Error("custom host")
+	}
+}
@@ -1,12 +1,16 @@
 package steamapi
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
+	"math"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Jleagle/unmarshal-go"
 )
@@ -81,18 +85,192 @@ type CommunityInventory struct {
 	MoreStart bool `json:"more_start"`
 }
 
+var ErrInventoryPrivate = errors.New("private inventory")
+
+// GetInventoryPage gets a page of the current inventory endpoint, pass an empty startAssetID for the first page.
+// contextID is usually 2, or 6 for Steam community items.
+func (c *Client) GetInventoryPage(playerID int64, appID int, contextID int64, count int, startAssetID string, language LanguageCode) (resp InventoryPage, b []byte, err error) {
+
+	vals := url.Values{}
+	if language != "" {
+		vals.Set("l", string(language))
+	}
+	if count > 0 {
+		vals.Set("count", strconv.Itoa(count))
+	}
+	if startAssetID != "" {
+		vals.Set("start_assetid", startAssetID)
+	}
+
+	b, code, urlx, err := c.getFromCommunity("inventory/"+strconv.FormatInt(playerID, 10)+"/"+strconv.Itoa(appID)+"/"+strconv.FormatInt(contextID, 10), vals)
+	if err != nil {
+		return resp, b, err
+	}
+
+	// Private inventories and rate limits both return a null body
+	switch code {
+	case http.StatusOK:
+	case http.StatusForbidden:
+		return resp, b, ErrInventoryPrivate
+	case http.StatusTooManyRequests:
+		return resp, b, ErrRateLimited
+	default:
+		return resp, b, Error{Err: http.StatusText(code), Code: code, URL: urlx}
+	}
+
+	if len(b) == 0 || string(b) == "null" {
+		return resp, b, ErrRateLimited
+	}
+
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if resp.Success != 1 {
+		return resp, b, Error{Err: "unsuccessful response", Code: code, URL: urlx}
+	}
+
+	return resp, b, nil
+}
+
+// WalkInventory calls fn with every item in an inventory, until there are no more items or fn returns false
+func (c *Client) WalkInventory(playerID int64, appID int, contextID int64, language LanguageCode, fn func(item InventoryItem) bool) error {
+
+	var start string
+
+	for {
+		page, _, err := c.GetInventoryPage(playerID, appID, contextID, 2000, start, language)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.GetItems() {
+			if !fn(item) {
+				return nil
+			}
+		}
+
+		if page.MoreItems != 1 || page.LastAssetID == "" || page.LastAssetID == start {
+			return nil
+		}
+
+		start = page.LastAssetID
+	}
+}
+
+type InventoryPage struct {
+	Assets              []InventoryAsset       `json:"assets"`
+	Descriptions        []InventoryDescription `json:"descriptions"`
+	MoreItems           int                    `json:"more_items"`
+	LastAssetID         string                 `json:"last_assetid"`
+	TotalInventoryCount int                    `json:"total_inventory_count"`
+	Success             int                    `json:"success"`
+}
+
+// GetItems joins each asset to its description
+func (p InventoryPage) GetItems() (items []InventoryItem) {
+
+	descriptions := map[string]InventoryDescription{}
+	for _, v := range p.Descriptions {
+		descriptions[v.ClassID+"_"+v.InstanceID] = v
+	}
+
+	for _, v := range p.Assets {
+		items = append(items, InventoryItem{
+			InventoryAsset: v,
+			Description:    descriptions[v.ClassID+"_"+v.InstanceID],
+		})
+	}
+
+	return items
+}
+
+type InventoryItem struct {
+	InventoryAsset
+	Description InventoryDescription
+}
+
+type InventoryAsset struct {
+	AppID      int           `json:"appid"`
+	ContextID  string        `json:"contextid"`
+	AssetID    string        `json:"assetid"`
+	ClassID    string        `json:"classid"`
+	InstanceID string        `json:"instanceid"`
+	Amount     unmarshal.Int `json:"amount"`
+}
+
+type InventoryDescription struct {
+	AppID           int    `json:"appid"`
+	ClassID         string `json:"classid"`
+	InstanceID      string `json:"instanceid"`
+	Currency        int    `json:"currency"`
+	BackgroundColor string `json:"background_color"`
+	IconURL         string `json:"icon_url"`
+	IconURLLarge    string `json:"icon_url_large"`
+	Descriptions    []struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+		Color string `json:"color"`
+	} `json:"descriptions"`
+	Actions []struct {
+		Link string `json:"link"`
+		Name string `json:"name"`
+	} `json:"actions"`
+	Name           string `json:"name"`
+	NameColor      string `json:"name_color"`
+	Type           string `json:"type"`
+	MarketName     string `json:"market_name"`
+	MarketHashName string `json:"market_hash_name"`
+	MarketActions  []struct {
+		Link string `json:"link"`
+		Name string `json:"name"`
+	} `json:"market_actions"`
+	Commodity                   unmarshal.Bool `json:"commodity"`
+	Tradable                    unmarshal.Bool `json:"tradable"`
+	Marketable                  unmarshal.Bool `json:"marketable"`
+	MarketTradableRestriction   unmarshal.Int  `json:"market_tradable_restriction"`
+	MarketMarketableRestriction unmarshal.Int  `json:"market_marketable_restriction"`
+	Tags                        []struct {
+		Category              string `json:"category"`
+		InternalName          string `json:"internal_name"`
+		LocalizedCategoryName string `json:"localized_category_name"`
+		LocalizedTagName      string `json:"localized_tag_name"`
+		Color                 string `json:"color"`
+	} `json:"tags"`
+}
+
+func (d InventoryDescription) GetIconURL(width int, height int) string {
+	return DefaultCDN.EconomyImage(d.IconURL, width, height)
+}
+
 type MarketSearchPayload struct {
+	Query                string
 	FriendlyDescriptions bool
 	SortColumn           string
 	SortOrder            bool
 	AppID                int
+	Currency             CurrencyCode        // Defaults to USD
+	Filters              map[string][]string // eg "category_730_Exterior": {"tag_WearCategory2"}
 	Limit                int
 	Offset               int
 }
 
 func (c *Client) GetMarketSearch(payload MarketSearchPayload) (resp MarketSearch, b []byte, err error) {
 
+	if payload.Currency == "" {
+		payload.Currency = CurrencyUSD
+	}
+
+	currencyID, ok := CurrencyIDs[payload.Currency]
+	if !ok {
+		return resp, b, ErrUnknownCurrency
+	}
+
 	vals := url.Values{}
+	if payload.Query != "" {
+		vals.Set("query", payload.Query)
+	}
 	if payload.FriendlyDescriptions {
 		vals.Set("search_descriptions", "1")
 	} else {
@@ -109,20 +287,72 @@ func (c *Client) GetMarketSearch(payload MarketSearchPayload) (resp MarketSearch
 	if payload.AppID > 0 {
 		vals.Set("appid", strconv.Itoa(payload.AppID))
 	}
+	for k, v := range payload.Filters {
+		k = strings.TrimSuffix(k, "[]") + "[]"
+		for _, tag := range v {
+			vals.Add(k, tag)
+		}
+	}
 	if payload.Limit > 0 {
 		vals.Set("count", strconv.Itoa(payload.Limit))
 	}
 	vals.Set("start", strconv.Itoa(payload.Offset))
+	vals.Set("currency", strconv.Itoa(currencyID))
 	vals.Set("norender", "1")
 
-	b, err = c.getFromStore("market/search/render", vals)
+	b, _, _, err = c.getFromCommunity("market/search/render/", vals)
 	if err != nil {
 		return resp, b, err
 	}
 
-	//
+	if string(b) == "null" {
+		return resp, b, ErrRateLimited
+	}
+
 	err = json.Unmarshal(b, &resp)
-	return resp, b, err
+	if err != nil {
+		return resp, b, err
+	}
+
+	for k := range resp.Results {
+		resp.Results[k].Currency = payload.Currency
+	}
+
+	return resp, b, nil
+}
+
+// WalkMarketSearch calls fn with every search result, a page of Limit at a time, until
+// TotalCount is reached, a page comes back empty or fn returns false.
+// Market search allows about 20 requests a minute, set SetCommunityRateLimit before walking
+// or the walk will stop with ErrRateLimited.
+func (c *Client) WalkMarketSearch(payload MarketSearchPayload, fn func(result MarketSearchResult) bool) error {
+
+	if payload.Limit <= 0 {
+		payload.Limit = 100
+	}
+
+	for {
+		resp, _, err := c.GetMarketSearch(payload)
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Results) == 0 {
+			return nil
+		}
+
+		for _, result := range resp.Results {
+			if !fn(result) {
+				return nil
+			}
+		}
+
+		payload.Offset += len(resp.Results)
+
+		if payload.Offset >= resp.TotalCount {
+			return nil
+		}
+	}
 }
 
 type MarketSearch struct {
@@ -138,49 +368,191 @@ type MarketSearch struct {
 		Prefix             string `json:"prefix"`
 		ClassPrefix        string `json:"class_prefix"`
 	} `json:"searchdata"`
-	Results []struct {
-		Name             string `json:"name"`
-		HashName         string `json:"hash_name"`
-		SellListings     int    `json:"sell_listings"`
-		SellPrice        int    `json:"sell_price"`
-		SellPriceText    string `json:"sell_price_text"`
-		AppIcon          string `json:"app_icon"`
-		AppName          string `json:"app_name"`
-		AssetDescription struct {
-			Appid                       int    `json:"appid"`
-			Classid                     string `json:"classid"`
-			Instanceid                  string `json:"instanceid"`
-			Currency                    int    `json:"currency"`
-			BackgroundColor             string `json:"background_color"`
-			IconURL                     string `json:"icon_url"`
-			IconURLLarge                string `json:"icon_url_large"`
-			Tradable                    int    `json:"tradable"`
-			Name                        string `json:"name"`
-			Type                        string `json:"type"`
-			MarketName                  string `json:"market_name"`
-			MarketHashName              string `json:"market_hash_name"`
-			Commodity                   int    `json:"commodity"`
-			MarketTradableRestriction   int    `json:"market_tradable_restriction"`
-			MarketMarketableRestriction int    `json:"market_marketable_restriction"`
-			Marketable                  int    `json:"marketable"`
-		} `json:"asset_description"`
-		SalePriceText string `json:"sale_price_text"`
-	} `json:"results"`
-}
-
-func GetPriceOverview() (resp PriceOverview, b []byte, err error) {
-
-	// http://steamcommunity.com/market/priceoverview/?appid=730&currency=3&market_hash_name=StatTrak%E2%84%A2 M4A1-S | Hyper Beast (Minimal Wear)
+	Results []MarketSearchResult `json:"results"`
+}
 
-	return resp, b, err
+type MarketSearchResult struct {
+	Currency         CurrencyCode `json:"-"` // Not in Steam response
+	Name             string       `json:"name"`
+	HashName         string       `json:"hash_name"`
+	SellListings     int          `json:"sell_listings"`
+	SellPrice        int          `json:"sell_price"`
+	SellPriceText    string       `json:"sell_price_text"`
+	AppIcon          string       `json:"app_icon"`
+	AppName          string       `json:"app_name"`
+	AssetDescription struct {
+		Appid                       int    `json:"appid"`
+		Classid                     string `json:"classid"`
+		Instanceid                  string `json:"instanceid"`
+		Currency                    int    `json:"currency"`
+		BackgroundColor             string `json:"background_color"`
+		IconURL                     string `json:"icon_url"`
+		IconURLLarge                string `json:"icon_url_large"`
+		Tradable                    int    `json:"tradable"`
+		Name                        string `json:"name"`
+		Type                        string `json:"type"`
+		MarketName                  string `json:"market_name"`
+		MarketHashName              string `json:"market_hash_name"`
+		Commodity                   int    `json:"commodity"`
+		MarketTradableRestriction   int    `json:"market_tradable_restriction"`
+		MarketMarketableRestriction int    `json:"market_marketable_restriction"`
+		Marketable                  int    `json:"marketable"`
+	} `json:"asset_description"`
+	SalePriceText string `json:"sale_price_text"`
+}
+
+// GetSellPrice returns the lowest listing price, including fees
+func (r MarketSearchResult) GetSellPrice() Money {
+	return Money{Amount: int64(r.SellPrice), Currency: r.Currency}
+}
+
+var ErrMarketLogin = errors.New("market needs a logged in session")
+
+func (c *Client) GetPriceOverview(appID int, currency CurrencyCode, marketHashName string) (resp PriceOverview, b []byte, err error) {
+
+	currencyID, ok := CurrencyIDs[currency]
+	if !ok {
+		return resp, b, ErrUnknownCurrency
+	}
+
+	vals := url.Values{}
+	vals.Set("appid", strconv.Itoa(appID))
+	vals.Set("currency", strconv.Itoa(currencyID))
+	vals.Set("market_hash_name", marketHashName)
 
+	b, _, _, err = c.getFromCommunity("market/priceoverview/", vals)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if string(b) == "null" {
+		return resp, b, ErrRateLimited
+	}
+
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return resp, b, err
+	}
+
+	resp.Currency = currency
+	return resp, b, nil
 }
 
 type PriceOverview struct {
-	Success     bool   `json:"success"`
-	LowestPrice string `json:"lowest_price"`
-	Volume      string `json:"volume"`
-	MedianPrice string `json:"median_price"`
+	Currency    CurrencyCode `json:"-"` // Not in Steam response
+	Success     bool         `json:"success"`
+	LowestPrice string       `json:"lowest_price"`
+	Volume      string       `json:"volume"`
+	MedianPrice string       `json:"median_price"`
+}
+
+func (p PriceOverview) GetLowestPrice() (Money, error) {
+	return ParseMoney(p.LowestPrice, p.Currency)
+}
+
+func (p PriceOverview) GetMedianPrice() (Money, error) {
+	return ParseMoney(p.MedianPrice, p.Currency)
+}
+
+// GetVolume returns the number sold in the last 24 hours
+func (p PriceOverview) GetVolume() int {
+	i, _ := strconv.Atoi(strings.NewReplacer(",", "", ".", "", " ", "").Replace(p.Volume))
+	return i
+}
+
+// GetPriceHistory needs a logged in session, see SetCommunityCookies
+func (c *Client) GetPriceHistory(appID int, currency CurrencyCode, marketHashName string) (points []PriceHistoryPoint, b []byte, err error) {
+
+	currencyID, ok := CurrencyIDs[currency]
+	if !ok {
+		return points, b, ErrUnknownCurrency
+	}
+
+	vals := url.Values{}
+	vals.Set("appid", strconv.Itoa(appID))
+	vals.Set("currency", strconv.Itoa(currencyID))
+	vals.Set("market_hash_name", marketHashName)
+
+	b, _, _, err = c.getFromCommunity("market/pricehistory/", vals)
+	if err != nil {
+		return points, b, err
+	}
+
+	// Logged out sessions get an empty array
+	if string(b) == "[]" || string(b) == "null" || len(b) == 0 {
+		return points, b, ErrMarketLogin
+	}
+
+	var resp PriceHistoryResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return points, b, err
+	}
+
+	if !resp.Success {
+		return points, b, ErrMarketLogin
+	}
+
+	for _, v := range resp.Prices {
+
+		point, err := parsePriceHistoryPoint(v, currency)
+		if err != nil {
+			return points, b, err
+		}
+
+		points = append(points, point)
+	}
+
+	return points, b, nil
+}
+
+type PriceHistoryResponse struct {
+	Success     bool                `json:"success"`
+	PricePrefix string              `json:"price_prefix"`
+	PriceSuffix string              `json:"price_suffix"`
+	Prices      [][]json.RawMessage `json:"prices"` // Date, median price, volume
+}
+
+type PriceHistoryPoint struct {
+	Time   time.Time
+	Median Money
+	Volume int
+}
+
+// Dates look like "Jul 02 2014 01: +0", always UTC
+func parsePriceHistoryPoint(row []json.RawMessage, currency CurrencyCode) (point PriceHistoryPoint, err error) {
+
+	if len(row) < 3 {
+		return point, errors.New("invalid price history row")
+	}
+
+	var date string
+	var median float64
+	var volume string
+
+	if err = json.Unmarshal(row[0], &date); err != nil {
+		return point, err
+	}
+	if err = json.Unmarshal(row[1], &median); err != nil {
+		return point, err
+	}
+	if err = json.Unmarshal(row[2], &volume); err != nil {
+		return point, err
+	}
+
+	if i := strings.Index(date, ":"); i > -1 {
+		date = date[:i]
+	}
+
+	point.Time, err = time.Parse("Jan 02 2006 15", date)
+	if err != nil {
+		return point, err
+	}
+
+	point.Median = Money{Amount: int64(math.Round(median * 100)), Currency: currency}
+	point.Volume, err = strconv.Atoi(volume)
+
+	return point, err
 }
 
 var ErrRateLimited = errors.New("rate limited")
@@ -194,9 +566,9 @@ func (c *Client) GetGroup(id string, vanityURL string, page int) (resp GroupInfo
 
 	var urlx string
 	if id != "" {
-		b, urlx, err = c.getFromCommunity("gid/"+id+"/memberslistxml", vals)
+		b, _, urlx, err = c.getFromCommunity("gid/"+id+"/memberslistxml", vals)
 	} else if vanityURL != "" {
-		b, urlx, err = c.getFromCommunity("groups/"+vanityURL+"/memberslistxml", vals)
+		b, _, urlx, err = c.getFromCommunity("groups/"+vanityURL+"/memberslistxml", vals)
 	} else {
 		return resp, b, errors.New("missing id/vanity")
 	}
@@ -259,7 +631,7 @@ func (c *Client) GetComments(playerID int64, limit int, offset int) (resp Commen
 		vals.Set("start", strconv.Itoa(offset))
 	}
 
-	b, _, err = c.getFromCommunity("comment/Profile/render/"+strconv.FormatInt(playerID, 10), vals)
+	b, _, _, err = c.getFromCommunity("comment/Profile/render/"+strconv.FormatInt(playerID, 10), vals)
 	if err != nil {
 		return resp, b, err
 	}
@@ -287,7 +659,7 @@ type Comments struct {
 
 func (c *Client) GetAliases(playerID int64) (resp []Alias, b []byte, err error) {
 
-	b, _, err = c.getFromCommunity("profiles/"+strconv.FormatInt(playerID, 10)+"/ajaxaliases", nil)
+	b, _, _, err = c.getFromCommunity("profiles/"+strconv.FormatInt(playerID, 10)+"/ajaxaliases", nil)
 	if err != nil {
 		return resp, b, err
 	}
@@ -304,3 +676,105 @@ type Alias struct {
 	Alias string `json:"newname"`
 	Time  string `json:"timechanged"`
 }
+
+func (c *Client) GetLeaderboards(appID int) (resp CommunityLeaderboards, b []byte, err error) {
+
+	vals := url.Values{}
+	vals.Set("xml", "1")
+
+	b, _, _, err = c.getFromCommunity("stats/"+strconv.Itoa(appID)+"/leaderboards/", vals)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if !bytes.HasPrefix(b, []byte("<?xml")) {
+		return resp, b, ErrHTMLResponse
+	}
+
+	err = xml.Unmarshal(b, &resp)
+	return resp, b, err
+}
+
+type CommunityLeaderboards struct {
+	XMLName      xml.Name      `xml:"response"`
+	AppID        unmarshal.Int `xml:"appID"`
+	AppName      string        `xml:"appFriendlyName"`
+	Count        unmarshal.Int `xml:"leaderboardCount"`
+	Leaderboards []struct {
+		URL         string        `xml:"url"`
+		ID          unmarshal.Int `xml:"lbid"`
+		Name        string        `xml:"name"`
+		DisplayName string        `xml:"display_name"`
+		Entries     unmarshal.Int `xml:"entries"`
+		SortMethod  unmarshal.Int `xml:"sortmethod"`  // 1 ascending, 2 descending
+		DisplayType unmarshal.Int `xml:"displaytype"` // 1 numeric, 2 seconds, 3 milliseconds
+	} `xml:"leaderboard"`
+}
+
+// Start and end are 1 based ranks. If playerID is set, the entries are around that player instead.
+func (c *Client) GetLeaderboard(appID int, leaderboardID int, start int, end int, playerID int64) (resp CommunityLeaderboard, b []byte, err error) {
+
+	vals := url.Values{}
+	vals.Set("xml", "1")
+	if playerID > 0 {
+		vals.Set("steamid", strconv.FormatInt(playerID, 10))
+	} else {
+		vals.Set("start", strconv.Itoa(start))
+		vals.Set("end", strconv.Itoa(end))
+	}
+
+	b, _, _, err = c.getFromCommunity("stats/"+strconv.Itoa(appID)+"/leaderboards/"+strconv.Itoa(leaderboardID)+"/", vals)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if !bytes.HasPrefix(b, []byte("<?xml")) {
+		return resp, b, ErrHTMLResponse
+	}
+
+	err = xml.Unmarshal(b, &resp)
+	return resp, b, err
+}
+
+type CommunityLeaderboard struct {
+	XMLName       xml.Name      `xml:"response"`
+	AppID         unmarshal.Int `xml:"appID"`
+	AppName       string        `xml:"appFriendlyName"`
+	LeaderboardID unmarshal.Int `xml:"leaderboardID"`
+	TotalEntries  unmarshal.Int `xml:"totalLeaderboardEntries"`
+	EntryStart    unmarshal.Int `xml:"entryStart"`
+	EntryEnd      unmarshal.Int `xml:"entryEnd"`
+	NextRequest   string        `xml:"nextRequestURL"`
+	ResultCount   unmarshal.Int `xml:"resultCount"`
+	Entries       []struct {
+		SteamID unmarshal.Int64 `xml:"steamid"`
+		Score   unmarshal.Int   `xml:"score"`
+		Rank    unmarshal.Int   `xml:"rank"`
+		UGCID   unmarshal.Int64 `xml:"ugcid"`
+		Details string          `xml:"details"`
+	} `xml:"entries>entry"`
+}
+
+// WalkLeaderboard calls fn with every page of a leaderboard, until fn returns false or there are no more entries
+func (c *Client) WalkLeaderboard(appID int, leaderboardID int, pageSize int, fn func(page CommunityLeaderboard) bool) error {
+
+	if pageSize < 1 {
+		pageSize = 100
+	}
+
+	for start := 1; ; start += pageSize {
+
+		page, _, err := c.GetLeaderboard(appID, leaderboardID, start, start+pageSize-1, 0)
+		if err != nil {
+			return err
+		}
+
+		if len(page.Entries) == 0 || !fn(page) {
+			return nil
+		}
+
+		if int(page.EntryEnd) >= int(page.TotalEntries) {
+			return nil
+		}
+	}
+}
@@ -1,7 +1,14 @@
 package steamapi
 
 import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestPlayers(t *testing.T) {
@@ -26,3 +33,181 @@ func TestGroups(t *testing.T) {
 		t.Error("name")
 	}
 }
+
+func TestPriceHistoryPoint(t *testing.T) {
+
+	var resp PriceHistoryResponse
+	err := json.Unmarshal([]byte(`{"success":true,"prices":[["Jul 02 2014 01: +0",417.777,"40"]]}`), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	point, err := parsePriceHistoryPoint(resp.Prices[0], CurrencyUSD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !point.Time.Equal(time.Date(2014, 7, 2, 1, 0, 0, 0, time.UTC)) {
+		t.Error("time", point.Time)
+	}
+	if point.Median.Amount != 41778 {
+		t.Error("median", point.Median.Amount)
+	}
+	if point.Volume != 40 {
+		t.Error("volume", point.Volume)
+	}
+}
+
+func TestInventoryPageItems(t *testing.T) {
+
+	var page InventoryPage
+	err := json.Unmarshal([]byte(`{
+		"assets": [
+			{"appid": 730, "contextid": "2", "assetid": "1", "classid": "100", "instanceid": "0", "amount": "1"},
+			{"appid": 730, "contextid": "2", "assetid": "2", "classid": "100", "instanceid": "5", "amount": "1"},
+			{"appid": 730, "contextid": "2", "assetid": "3", "classid": "200", "instanceid": "0", "amount": "3"}
+		],
+		"descriptions": [
+			{"appid": 730, "classid": "100", "instanceid": "0", "market_hash_name": "A"},
+			{"appid": 730, "classid": "100", "instanceid": "5", "market_hash_name": "B"},
+			{"appid": 730, "classid": "200", "instanceid": "0", "market_hash_name": "C", "tradable": 1}
+		],
+		"total_inventory_count": 3,
+		"success": 1
+	}`), &page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := page.GetItems()
+	if len(items) != 3 {
+		t.Fatal("count", len(items))
+	}
+	if items[0].Description.MarketHashName != "A" || items[1].Description.MarketHashName != "B" {
+		t.Error("instance join", items[0].Description.MarketHashName, items[1].Description.MarketHashName)
+	}
+	if items[2].AssetID != "3" || items[2].Amount != 3 || !bool(items[2].Description.Tradable) {
+		t.Error("asset", items[2])
+	}
+}
+
+func TestMarketUnknownCurrency(t *testing.T) {
+
+	c := NewClient()
+
+	if _, _, err := c.GetPriceOverview(730, "XXX", "AK-47 | Redline (Field-Tested)"); err != ErrUnknownCurrency {
+		t.Error("price overview", err)
+	}
+	if _, _, err := c.GetMarketSearch(MarketSearchPayload{Currency: "XXX"}); err != ErrUnknownCurrency {
+		t.Error("search", err)
+	}
+}
+
+func TestLeaderboardsDecoding(t *testing.T) {
+
+	var boards CommunityLeaderboards
+	err := xml.Unmarshal([]byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<response>
+	<appID>620</appID>
+	<appFriendlyName>Portal2</appFriendlyName>
+	<leaderboardCount>2</leaderboardCount>
+	<leaderboard>
+		<url><![CDATA[https://steamcommunity.com/stats/620/leaderboards/47459/?xml=1]]></url>
+		<lbid>47459</lbid>
+		<name><![CDATA[challenge_besttime_sp_a1_intro1]]></name>
+		<display_name><![CDATA[Container Ride]]></display_name>
+		<entries>1000</entries>
+		<sortmethod>1</sortmethod>
+		<displaytype>3</displaytype>
+	</leaderboard>
+	<leaderboard>
+		<lbid>47460</lbid>
+		<name><![CDATA[challenge_portals_sp_a1_intro1]]></name>
+		<entries>5</entries>
+	</leaderboard>
+</response>`), &boards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if boards.AppID != 620 || boards.Count != 2 || len(boards.Leaderboards) != 2 {
+		t.Fatal("boards", boards)
+	}
+	if boards.Leaderboards[0].ID != 47459 || boards.Leaderboards[0].DisplayName != "Container Ride" || boards.Leaderboards[0].DisplayType != 3 {
+		t.Error("board", boards.Leaderboards[0])
+	}
+
+	var board CommunityLeaderboard
+	err = xml.Unmarshal([]byte(leaderboardPageXML(1, 2, 3)), &board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if board.LeaderboardID != 47459 || board.TotalEntries != 3 || board.EntryEnd != 2 || len(board.Entries) != 2 {
+		t.Fatal("board", board)
+	}
+	if board.Entries[1].SteamID != 76561197960287932 || board.Entries[1].Rank != 2 || board.Entries[1].Score != 2 {
+		t.Error("entry", board.Entries[1])
+	}
+}
+
+func leaderboardPageXML(start int, end int, total int) string {
+
+	var entries string
+	for i := start; i <= end && i <= total; i++ {
+		entries += `<entry><steamid>` + strconv.Itoa(76561197960287930+i) + `</steamid><score>` + strconv.Itoa(i) + `</score><rank>` + strconv.Itoa(i) + `</rank><ugcid>-1</ugcid><details><![CDATA[]]></details></entry>`
+	}
+
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<response>
+	<appID>620</appID>
+	<leaderboardID>47459</leaderboardID>
+	<totalLeaderboardEntries>` + strconv.Itoa(total) + `</totalLeaderboardEntries>
+	<entryStart>` + strconv.Itoa(start) + `</entryStart>
+	<entryEnd>` + strconv.Itoa(end) + `</entryEnd>
+	<entries>` + entries + `</entries>
+</response>`
+}
+
+type leaderboardTransport struct {
+	total    int
+	requests int
+}
+
+func (l *leaderboardTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	l.requests++
+
+	start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+	end, _ := strconv.Atoi(req.URL.Query().Get("end"))
+	if end > l.total {
+		end = l.total
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(leaderboardPageXML(start, end, l.total))),
+		Request:    req,
+	}, nil
+}
+
+func TestWalkLeaderboard(t *testing.T) {
+
+	transport := &leaderboardTransport{total: 5}
+
+	c := NewClient()
+	c.client = &http.Client{Transport: transport}
+
+	var ranks []int
+	err := c.WalkLeaderboard(620, 47459, 2, func(page CommunityLeaderboard) bool {
+		for _, v := range page.Entries {
+			ranks = append(ranks, int(v.Rank))
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pages 1-2, 3-4 and 5, then stop at entryEnd >= totalLeaderboardEntries
+	if len(ranks) != 5 || ranks[4] != 5 || transport.requests != 3 {
+		t.Error("walk", ranks, transport.requests)
+	}
+}
@@ -0,0 +1,142 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type DeckCategory int
+
+// noinspection GoUnusedConst
+const (
+	DeckCategoryUnknown     DeckCategory = 0
+	DeckCategoryUnsupported DeckCategory = 1
+	DeckCategoryPlayable    DeckCategory = 2
+	DeckCategoryVerified    DeckCategory = 3
+)
+
+func (c DeckCategory) String() string {
+	switch c {
+	case DeckCategoryUnsupported:
+		return "Unsupported"
+	case DeckCategoryPlayable:
+		return "Playable"
+	case DeckCategoryVerified:
+		return "Verified"
+	default:
+		return "Unknown"
+	}
+}
+
+type DeckResultType int
+
+// noinspection GoUnusedConst
+const (
+	DeckResultInfo        DeckResultType = 1
+	DeckResultUnsupported DeckResultType = 2
+	DeckResultPlayable    DeckResultType = 3 // Works, with a caveat
+	DeckResultVerified    DeckResultType = 4
+)
+
+func (c *Client) GetDeckCompatibility(appID int, cc ProductCC, language LanguageCode) (report DeckCompatibility, err error) {
+
+	query := url.Values{}
+	query.Set("nAppID", strconv.Itoa(appID))
+	query.Set("cc", string(cc))
+	query.Set("l", string(language))
+
+	b, err := c.getFromStore("saleaction/ajaxgetdeckappcompatibilityreport", query)
+	if err != nil {
+		return report, err
+	}
+
+	if string(b) == "null" {
+		return report, ErrNullResponse
+	}
+	if strings.HasPrefix(string(b), "<") {
+		return report, ErrHTMLResponse
+	}
+
+	var resp DeckCompatibilityResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return report, err
+	}
+
+	if resp.Success != 1 {
+		return report, ErrAppNotFound
+	}
+
+	return resp.Results, nil
+}
+
+type DeckCompatibilityResponse struct {
+	Success int               `json:"success"`
+	Results DeckCompatibility `json:"results"`
+}
+
+type DeckCompatibility struct {
+	AppID                   int          `json:"appid"`
+	ResolvedCategory        DeckCategory `json:"resolved_category"`
+	ResolvedItems           []DeckResult `json:"resolved_items"`
+	SteamOSResolvedCategory DeckCategory `json:"steamos_resolved_category"`
+	SteamOSResolvedItems    []DeckResult `json:"steamos_resolved_items"`
+	SteamDeckBlogURL        string       `json:"steam_deck_blog_url"`
+}
+
+// Failed returns the test results that stopped the app being verified
+func (d DeckCompatibility) Failed() (results []DeckResult) {
+	for _, v := range d.ResolvedItems {
+		if v.DisplayType == DeckResultUnsupported || v.DisplayType == DeckResultPlayable {
+			results = append(results, v)
+		}
+	}
+	return results
+}
+
+type DeckResult struct {
+	DisplayType DeckResultType `json:"display_type"`
+	LocToken    string         `json:"loc_token"` // eg "#SteamDeckVerified_TestResult_DefaultControllerConfigFullyFunctional"
+}
+
+// GetName returns the test name from the localization token, eg "DefaultControllerConfigFullyFunctional"
+func (r DeckResult) GetName() string {
+	i := strings.LastIndex(r.LocToken, "_")
+	return r.LocToken[i+1:]
+}
+
+// AppCompatibility has everything the store knows about an app and where it runs
+type AppCompatibility struct {
+	Details   AppDetails
+	Deck      DeckCompatibility
+	Platforms StoreItemPlatforms
+	Assets    StoreItemAssets
+}
+
+// GetAppCompatibility joins an app's details, its Deck test results and its IStoreBrowseService platforms and assets
+func (c *Client) GetAppCompatibility(appID int, cc ProductCC, language LanguageCode) (compat AppCompatibility, err error) {
+
+	compat.Details, err = c.GetAppDetails(uint(appID), cc, language, nil)
+	if err != nil {
+		return compat, err
+	}
+
+	compat.Deck, err = c.GetDeckCompatibility(appID, cc, language)
+	if err != nil {
+		return compat, err
+	}
+
+	items, err := c.GetItems([]StoreBrowseID{{AppID: appID}}, cc, language, StoreBrowseDataRequest{IncludeAssets: true, IncludePlatforms: true})
+	if err != nil {
+		return compat, err
+	}
+
+	if len(items) > 0 {
+		compat.Platforms = items[0].Platforms
+		compat.Assets = items[0].Assets
+	}
+
+	return compat, nil
+}
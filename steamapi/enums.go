@@ -1,6 +1,7 @@
 package steamapi
 
 import (
+	"errors"
 	"strings"
 )
 
@@ -368,6 +369,69 @@ var Currencies = []Currency{
 	{CurrencyCode: CurrencyVND, Description: "Vietnamese Dong"},
 }
 
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// Steam's numeric IDs for currencies, used by the market
+var CurrencyIDs = map[CurrencyCode]int{
+	CurrencyUSD: 1,
+	CurrencyGBP: 2,
+	CurrencyEUR: 3,
+	CurrencyCHF: 4,
+	CurrencyRUB: 5,
+	CurrencyPLN: 6,
+	CurrencyBRL: 7,
+	CurrencyJPY: 8,
+	CurrencyNOK: 9,
+	CurrencyIDR: 10,
+	CurrencyMYR: 11,
+	CurrencyPHP: 12,
+	CurrencySGD: 13,
+	CurrencyTHB: 14,
+	CurrencyVND: 15,
+	CurrencyKRW: 16,
+	CurrencyTRY: 17,
+	CurrencyUAH: 18,
+	CurrencyMXN: 19,
+	CurrencyCAD: 20,
+	CurrencyAUD: 21,
+	CurrencyNZD: 22,
+	CurrencyCNY: 23,
+	CurrencyINR: 24,
+	CurrencyCLP: 25,
+	CurrencyPEN: 26,
+	CurrencyCOP: 27,
+	CurrencyZAR: 28,
+	CurrencyHKD: 29,
+	CurrencyTWD: 30,
+	CurrencySAR: 31,
+	CurrencyAED: 32,
+	CurrencyARS: 34,
+	CurrencyILS: 35,
+	CurrencyKZT: 37,
+	CurrencyKWD: 38,
+	CurrencyQAR: 39,
+	CurrencyCRC: 40,
+	CurrencyUYU: 41,
+}
+
+func GetCurrencyByID(id int) (code CurrencyCode, ok bool) {
+	for k, v := range CurrencyIDs {
+		if v == id {
+			return k, true
+		}
+	}
+	return code, false
+}
+
+func GetCurrency(code CurrencyCode) (currency Currency, ok bool) {
+	for _, v := range Currencies {
+		if v.CurrencyCode == code {
+			return v, true
+		}
+	}
+	return Currency{CurrencyCode: code}, false
+}
+
 // Country codes to get info on apps/packages
 type ProductCC string
 
@@ -0,0 +1,286 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/unmarshal-go"
+)
+
+var ErrMarketItemNotFound = errors.New("market item not found")
+
+func marketListingPath(appID int, marketHashName string) string {
+	return "market/listings/" + strconv.Itoa(appID) + "/" + url.PathEscape(marketHashName)
+}
+
+// GetMarketListings gets a page of sell listings for an item, prices are converted to currency
+func (c *Client) GetMarketListings(appID int, marketHashName string, currency CurrencyCode, start int, count int) (resp MarketListings, b []byte, err error) {
+
+	currencyID, ok := CurrencyIDs[currency]
+	if !ok {
+		return resp, b, ErrUnknownCurrency
+	}
+
+	vals := url.Values{}
+	vals.Set("start", strconv.Itoa(start))
+	vals.Set("count", strconv.Itoa(count))
+	vals.Set("currency", strconv.Itoa(currencyID))
+	vals.Set("language", string(LanguageEnglish))
+	vals.Set("format", "json")
+
+	b, _, _, err = c.getFromCommunity(marketListingPath(appID, marketHashName)+"/render/", vals)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if string(b) == "null" {
+		return resp, b, ErrRateLimited
+	}
+
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if !resp.Success {
+		return resp, b, ErrMarketItemNotFound
+	}
+
+	return resp, b, nil
+}
+
+type MarketListings struct {
+	Success     bool                                                  `json:"success"`
+	Start       int                                                   `json:"start"`
+	PageSize    int                                                   `json:"pagesize"`
+	TotalCount  int                                                   `json:"total_count"`
+	ListingInfo map[string]MarketListing                              `json:"listinginfo"`
+	Assets      map[string]map[string]map[string]InventoryDescription `json:"assets"` // App ID, context ID, asset ID
+}
+
+// Steam sends [] instead of {} when there are no listings
+func (l *MarketListings) UnmarshalJSON(b []byte) error {
+
+	type alias MarketListings
+
+	aux := struct {
+		*alias
+		ListingInfo json.RawMessage `json:"listinginfo"`
+		Assets      json.RawMessage `json:"assets"`
+	}{alias: (*alias)(l)}
+
+	err := json.Unmarshal(b, &aux)
+	if err != nil {
+		return err
+	}
+
+	l.ListingInfo = nil
+	l.Assets = nil
+
+	if !isEmptyJSON(aux.ListingInfo) {
+		err = json.Unmarshal(aux.ListingInfo, &l.ListingInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !isEmptyJSON(aux.Assets) {
+		err = json.Unmarshal(aux.Assets, &l.Assets)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetListings returns the listings joined to their asset descriptions, cheapest first
+func (l MarketListings) GetListings() (listings []MarketListing) {
+
+	for _, v := range l.ListingInfo {
+
+		if contexts, ok := l.Assets[strconv.Itoa(v.Asset.AppID)]; ok {
+			if assets, ok := contexts[v.Asset.ContextID]; ok {
+				v.Description = assets[v.Asset.ID]
+			}
+		}
+
+		listings = append(listings, v)
+	}
+
+	sort.Slice(listings, func(i, j int) bool {
+		a, b := listings[i].GetPrice().Amount, listings[j].GetPrice().Amount
+		if a == b {
+			return listings[i].ListingID < listings[j].ListingID
+		}
+		return a < b
+	})
+
+	return listings
+}
+
+type MarketListing struct {
+	ListingID           string `json:"listingid"`
+	Price               int64  `json:"price"` // In the seller's currency
+	Fee                 int64  `json:"fee"`
+	CurrencyID          int    `json:"currencyid"`
+	ConvertedPrice      int64  `json:"converted_price"` // In the requested currency
+	ConvertedFee        int64  `json:"converted_fee"`
+	ConvertedCurrencyID int    `json:"converted_currencyid"`
+	Asset               struct {
+		Currency  int           `json:"currency"`
+		AppID     int           `json:"appid"`
+		ContextID string        `json:"contextid"`
+		ID        string        `json:"id"`
+		Amount    unmarshal.Int `json:"amount"`
+	} `json:"asset"`
+	Description InventoryDescription `json:"-"`
+}
+
+// Market currency IDs are offset by 2000
+func (l MarketListing) GetCurrency() CurrencyCode {
+	code, _ := GetCurrencyByID(l.ConvertedCurrencyID - 2000)
+	return code
+}
+
+// GetPrice returns what a buyer pays, including fees
+func (l MarketListing) GetPrice() Money {
+	return Money{Amount: l.ConvertedPrice + l.ConvertedFee, Currency: l.GetCurrency()}
+}
+
+// GetSellerReceives returns the price after fees
+func (l MarketListing) GetSellerReceives() Money {
+	return Money{Amount: l.ConvertedPrice, Currency: l.GetCurrency()}
+}
+
+var itemNameIDRegex = regexp.MustCompile(`Market_LoadOrderSpread\(\s*([0-9]+)\s*\)`)
+
+// GetItemNameID gets the ID needed for GetItemOrdersHistogram from the listing page
+func (c *Client) GetItemNameID(appID int, marketHashName string) (id int, err error) {
+
+	b, _, _, err := c.getFromCommunity(marketListingPath(appID, marketHashName), nil)
+	if err != nil {
+		return id, err
+	}
+
+	match := itemNameIDRegex.FindSubmatch(b)
+	if match == nil {
+		return id, ErrMarketItemNotFound
+	}
+
+	return strconv.Atoi(string(match[1]))
+}
+
+// GetItemOrdersHistogram gets the buy and sell order book for an item
+func (c *Client) GetItemOrdersHistogram(itemNameID int, currency CurrencyCode, cc ProductCC) (resp ItemOrdersHistogram, b []byte, err error) {
+
+	currencyID, ok := CurrencyIDs[currency]
+	if !ok {
+		return resp, b, ErrUnknownCurrency
+	}
+
+	vals := url.Values{}
+	vals.Set("item_nameid", strconv.Itoa(itemNameID))
+	vals.Set("currency", strconv.Itoa(currencyID))
+	vals.Set("country", strings.ToUpper(string(cc)))
+	vals.Set("language", string(LanguageEnglish))
+	vals.Set("two_factor", "0")
+
+	b, _, _, err = c.getFromCommunity("market/itemordershistogram", vals)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if string(b) == "null" {
+		return resp, b, ErrRateLimited
+	}
+
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return resp, b, err
+	}
+
+	if resp.Success != 1 {
+		return resp, b, ErrMarketItemNotFound
+	}
+
+	resp.Currency = currency
+	return resp, b, nil
+}
+
+type ItemOrdersHistogram struct {
+	Currency         CurrencyCode        `json:"-"` // Not in Steam response
+	Success          int                 `json:"success"`
+	SellOrderSummary string              `json:"sell_order_summary"`
+	BuyOrderSummary  string              `json:"buy_order_summary"`
+	HighestBuyOrder  string              `json:"highest_buy_order"` // Hundredths
+	LowestSellOrder  string              `json:"lowest_sell_order"` // Hundredths
+	BuyOrderGraph    [][]json.RawMessage `json:"buy_order_graph"`   // Price, cumulative quantity, label
+	SellOrderGraph   [][]json.RawMessage `json:"sell_order_graph"`
+	PricePrefix      string              `json:"price_prefix"`
+	PriceSuffix      string              `json:"price_suffix"`
+}
+
+func (h ItemOrdersHistogram) GetHighestBuyOrder() Money {
+	i, _ := strconv.ParseInt(h.HighestBuyOrder, 10, 64)
+	return Money{Amount: i, Currency: h.Currency}
+}
+
+func (h ItemOrdersHistogram) GetLowestSellOrder() Money {
+	i, _ := strconv.ParseInt(h.LowestSellOrder, 10, 64)
+	return Money{Amount: i, Currency: h.Currency}
+}
+
+// GetBuyOrders returns the buy ladder, highest price first
+func (h ItemOrdersHistogram) GetBuyOrders() ([]OrderBookLevel, error) {
+	return parseOrderGraph(h.BuyOrderGraph, h.Currency)
+}
+
+// GetSellOrders returns the sell ladder, lowest price first
+func (h ItemOrdersHistogram) GetSellOrders() ([]OrderBookLevel, error) {
+	return parseOrderGraph(h.SellOrderGraph, h.Currency)
+}
+
+type OrderBookLevel struct {
+	Price      Money
+	Quantity   int // At this price
+	Cumulative int // At this price or better
+}
+
+func parseOrderGraph(graph [][]json.RawMessage, currency CurrencyCode) (levels []OrderBookLevel, err error) {
+
+	var previous int
+
+	for _, row := range graph {
+
+		if len(row) < 2 {
+			return levels, errors.New("invalid order graph row")
+		}
+
+		var price float64
+		var cumulative int
+
+		if err = json.Unmarshal(row[0], &price); err != nil {
+			return levels, err
+		}
+		if err = json.Unmarshal(row[1], &cumulative); err != nil {
+			return levels, err
+		}
+
+		levels = append(levels, OrderBookLevel{
+			Price:      Money{Amount: int64(math.Round(price * 100)), Currency: currency},
+			Quantity:   cumulative - previous,
+			Cumulative: cumulative,
+		})
+
+		previous = cumulative
+	}
+
+	return levels, nil
+}
@@ -0,0 +1,95 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarketListingsDecoding(t *testing.T) {
+
+	var empty MarketListings
+	err := json.Unmarshal([]byte(`{"success":true,"start":0,"pagesize":10,"total_count":0,"listinginfo":[],"assets":[]}`), &empty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(empty.GetListings()) != 0 {
+		t.Error("empty")
+	}
+
+	var resp MarketListings
+	err = json.Unmarshal([]byte(`{
+		"success": true,
+		"total_count": 2,
+		"listinginfo": {
+			"2": {"listingid": "2", "price": 200, "fee": 30, "currencyid": 2003, "converted_price": 220, "converted_fee": 33, "converted_currencyid": 2001,
+				"asset": {"currency": 0, "appid": 730, "contextid": "2", "id": "20", "amount": "1"}},
+			"1": {"listingid": "1", "price": 100, "fee": 15, "currencyid": 2001, "converted_price": 100, "converted_fee": 15, "converted_currencyid": 2001,
+				"asset": {"currency": 0, "appid": 730, "contextid": "2", "id": "10", "amount": "1"}}
+		},
+		"assets": {"730": {"2": {
+			"10": {"appid": 730, "classid": "1", "instanceid": "0", "market_hash_name": "AK-47 | Redline (Field-Tested)"},
+			"20": {"appid": 730, "classid": "1", "instanceid": "0", "market_hash_name": "AK-47 | Redline (Field-Tested)"}
+		}}}
+	}`), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listings := resp.GetListings()
+	if len(listings) != 2 {
+		t.Fatal("count", len(listings))
+	}
+	if listings[0].ListingID != "1" || listings[0].GetPrice().Amount != 115 || listings[0].GetCurrency() != CurrencyUSD {
+		t.Error("first", listings[0])
+	}
+	if listings[1].GetSellerReceives().Amount != 220 {
+		t.Error("seller receives", listings[1].GetSellerReceives())
+	}
+	if listings[1].Description.MarketHashName != "AK-47 | Redline (Field-Tested)" {
+		t.Error("description")
+	}
+}
+
+func TestItemOrdersHistogram(t *testing.T) {
+
+	var resp ItemOrdersHistogram
+	err := json.Unmarshal([]byte(`{
+		"success": 1,
+		"highest_buy_order": "1234",
+		"lowest_sell_order": "1301",
+		"buy_order_graph": [[12.34, 5, "5 buy orders at $12.34 or higher"], [12.3, 12, "12 buy orders at $12.30 or higher"]],
+		"sell_order_graph": [[13.01, 2, "2 sell orders at $13.01 or lower"], [13.05, 3, "3 sell orders at $13.05 or lower"]]
+	}`), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Currency = CurrencyUSD
+
+	if resp.GetHighestBuyOrder().Amount != 1234 || resp.GetLowestSellOrder().Amount != 1301 {
+		t.Error("summary")
+	}
+
+	buys, err := resp.GetBuyOrders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buys) != 2 || buys[1].Price.Amount != 1230 || buys[1].Quantity != 7 || buys[1].Cumulative != 12 {
+		t.Error("buys", buys)
+	}
+
+	sells, err := resp.GetSellOrders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sells) != 2 || sells[0].Quantity != 2 || sells[1].Quantity != 1 {
+		t.Error("sells", sells)
+	}
+}
+
+func TestItemNameIDRegex(t *testing.T) {
+
+	match := itemNameIDRegex.FindSubmatch([]byte(`<script>Market_LoadOrderSpread( 176321160 );</script>`))
+	if match == nil || string(match[1]) != "176321160" {
+		t.Error("item_nameid")
+	}
+}
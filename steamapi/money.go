@@ -0,0 +1,255 @@
+package steamapi
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPrice = errors.New("invalid price")
+var ErrMissingRate = errors.New("missing exchange rate")
+
+// Money is an amount in hundredths of a currency, the way Steam returns prices.
+// This is true even for currencies that Steam displays without decimals, like JPY.
+type Money struct {
+	Amount   int64
+	Currency CurrencyCode
+}
+
+func NewMoney(amount int, currency CurrencyCode) Money {
+	return Money{Amount: int64(amount), Currency: currency}
+}
+
+// Float64 returns the amount in major units, eg dollars
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / 100
+}
+
+// String formats the amount the same way as the store's formatted prices, eg "$19.99" or "19,99€"
+func (m Money) String() string {
+
+	format := GetCurrencyFormat(m.Currency)
+
+	amount := m.Amount
+	negative := amount < 0
+	if negative {
+		amount = -amount
+	}
+
+	// Amounts are always in hundredths, round to the decimals shown
+	whole := amount / 100
+	fraction := amount % 100
+
+	var decimals string
+	switch format.Decimals {
+	case 0:
+		if fraction >= 50 {
+			whole++
+		}
+	default:
+		if !(format.TrimZeroDecimals && fraction == 0) {
+			decimals = format.DecimalSeparator + padLeft(strconv.FormatInt(fraction, 10), 2)
+		}
+	}
+
+	number := groupThousands(strconv.FormatInt(whole, 10), format.ThousandsSeparator) + decimals
+	if negative {
+		number = "-" + number
+	}
+
+	var space string
+	if format.Space {
+		space = " "
+	}
+
+	if format.SymbolAfter {
+		return number + space + format.Symbol
+	}
+	return format.Symbol + space + number
+}
+
+// Convert changes the money into another currency, rounding to the nearest hundredth
+func (m Money) Convert(to CurrencyCode, rates ExchangeRates) (money Money, err error) {
+
+	if m.Currency == to {
+		return m, nil
+	}
+
+	rate, err := rates.Rate(m.Currency, to)
+	if err != nil {
+		return money, err
+	}
+
+	return Money{Amount: int64(math.Round(float64(m.Amount) * rate)), Currency: to}, nil
+}
+
+// ParseMoney reads a price formatted by Steam, eg "$19.99", "1 299 руб." or "¥ 1,980"
+func ParseMoney(s string, currency CurrencyCode) (money Money, err error) {
+
+	format := GetCurrencyFormat(currency)
+
+	s = strings.TrimSpace(strings.Replace(s, format.Symbol, "", 1))
+
+	negative := strings.HasPrefix(s, "-")
+
+	var whole, fraction string
+	var inFraction bool
+
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			if inFraction {
+				fraction += string(r)
+			} else {
+				whole += string(r)
+			}
+		case format.Decimals > 0 && string(r) == format.DecimalSeparator:
+			if inFraction {
+				return money, ErrInvalidPrice
+			}
+			inFraction = true
+		}
+	}
+
+	if whole == "" && fraction == "" {
+		return money, ErrInvalidPrice
+	}
+
+	fraction = (fraction + "00")[:2]
+	if whole == "" {
+		whole = "0"
+	}
+
+	amount, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return money, ErrInvalidPrice
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+func padLeft(s string, length int) string {
+	for len(s) < length {
+		s = "0" + s
+	}
+	return s
+}
+
+func groupThousands(s string, separator string) string {
+
+	if separator == "" || len(s) <= 3 {
+		return s
+	}
+
+	var groups []string
+	for len(s) > 3 {
+		groups = append([]string{s[len(s)-3:]}, groups...)
+		s = s[:len(s)-3]
+	}
+
+	return strings.Join(append([]string{s}, groups...), separator)
+}
+
+type CurrencyFormat struct {
+	Symbol             string
+	SymbolAfter        bool
+	Space              bool // Between the symbol and the number
+	Decimals           int  // Decimals shown, Steam amounts always have two
+	DecimalSeparator   string
+	ThousandsSeparator string
+	TrimZeroDecimals   bool // Hide decimals when they are zero, eg "599 руб."
+}
+
+// GetCurrencyFormat returns how Steam displays a currency, unknown currencies use the code as the symbol
+func GetCurrencyFormat(code CurrencyCode) CurrencyFormat {
+	if format, ok := CurrencyFormats[code]; ok {
+		return format
+	}
+	return CurrencyFormat{Symbol: string(code), Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","}
+}
+
+var CurrencyFormats = map[CurrencyCode]CurrencyFormat{
+	CurrencyAED: {Symbol: "AED", SymbolAfter: true, Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyARS: {Symbol: "ARS$", Space: true, Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: "."},
+	CurrencyAUD: {Symbol: "A$", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyBRL: {Symbol: "R$", Space: true, Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: "."},
+	CurrencyCAD: {Symbol: "CDN$", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyCHF: {Symbol: "CHF", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: "'"},
+	CurrencyCLP: {Symbol: "CLP$", Space: true, Decimals: 0, ThousandsSeparator: "."},
+	CurrencyCNY: {Symbol: "¥", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyCOP: {Symbol: "COL$", Space: true, Decimals: 0, ThousandsSeparator: "."},
+	CurrencyCRC: {Symbol: "₡", Decimals: 0, ThousandsSeparator: ","},
+	CurrencyEUR: {Symbol: "€", SymbolAfter: true, Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: "."},
+	CurrencyGBP: {Symbol: "£", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyHKD: {Symbol: "HK$", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyIDR: {Symbol: "Rp", Space: true, Decimals: 0, ThousandsSeparator: " "},
+	CurrencyILS: {Symbol: "₪", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyINR: {Symbol: "₹", Space: true, Decimals: 0, ThousandsSeparator: ","},
+	CurrencyJPY: {Symbol: "¥", Space: true, Decimals: 0, ThousandsSeparator: ","},
+	CurrencyKRW: {Symbol: "₩", Space: true, Decimals: 0, ThousandsSeparator: ","},
+	CurrencyKWD: {Symbol: "KD", SymbolAfter: true, Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyKZT: {Symbol: "₸", SymbolAfter: true, Decimals: 0, ThousandsSeparator: " "},
+	CurrencyMXN: {Symbol: "Mex$", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyMYR: {Symbol: "RM", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyNOK: {Symbol: "kr", SymbolAfter: true, Space: true, Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: " "},
+	CurrencyNZD: {Symbol: "NZ$", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyPEN: {Symbol: "S/.", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyPHP: {Symbol: "₱", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyPLN: {Symbol: "zł", SymbolAfter: true, Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: " "},
+	CurrencyQAR: {Symbol: "QR", SymbolAfter: true, Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyRUB: {Symbol: "руб.", SymbolAfter: true, Space: true, Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: " ", TrimZeroDecimals: true},
+	CurrencySAR: {Symbol: "SR", SymbolAfter: true, Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencySGD: {Symbol: "S$", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyTHB: {Symbol: "฿", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyTRY: {Symbol: "₺", Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: "."},
+	CurrencyTWD: {Symbol: "NT$", Space: true, Decimals: 0, ThousandsSeparator: ","},
+	CurrencyUAH: {Symbol: "₴", SymbolAfter: true, Decimals: 2, DecimalSeparator: ",", ThousandsSeparator: " ", TrimZeroDecimals: true},
+	CurrencyUSD: {Symbol: "$", Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: ","},
+	CurrencyUYU: {Symbol: "$U", Decimals: 0, ThousandsSeparator: ","},
+	CurrencyVND: {Symbol: "₫", SymbolAfter: true, Decimals: 0, ThousandsSeparator: "."},
+	CurrencyZAR: {Symbol: "R", Space: true, Decimals: 2, DecimalSeparator: ".", ThousandsSeparator: " "},
+}
+
+// ExchangeRates returns how many of one currency one unit of another is worth
+type ExchangeRates interface {
+	Rate(from CurrencyCode, to CurrencyCode) (float64, error)
+}
+
+// ExchangeRateTable holds the value of one unit of a base currency in other currencies
+type ExchangeRateTable struct {
+	Base  CurrencyCode
+	Rates map[CurrencyCode]float64
+}
+
+func (t ExchangeRateTable) Rate(from CurrencyCode, to CurrencyCode) (float64, error) {
+
+	fromRate, err := t.get(from)
+	if err != nil {
+		return 0, err
+	}
+
+	toRate, err := t.get(to)
+	if err != nil {
+		return 0, err
+	}
+
+	return toRate / fromRate, nil
+}
+
+func (t ExchangeRateTable) get(code CurrencyCode) (float64, error) {
+
+	if code == t.Base {
+		return 1, nil
+	}
+
+	rate, ok := t.Rates[code]
+	if !ok || rate <= 0 {
+		return 0, ErrMissingRate
+	}
+	return rate, nil
+}
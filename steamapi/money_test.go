@@ -0,0 +1,55 @@
+package steamapi
+
+import (
+	"testing"
+)
+
+func TestMoneyFormat(t *testing.T) {
+
+	m := map[string]Money{
+		"$19.99":        {Amount: 1999, Currency: CurrencyUSD},
+		"$1,299.00":     {Amount: 129900, Currency: CurrencyUSD},
+		"19,99€":        {Amount: 1999, Currency: CurrencyEUR},
+		"£7.19":         {Amount: 719, Currency: CurrencyGBP},
+		"599 руб.":      {Amount: 59900, Currency: CurrencyRUB},
+		"1 299,50 руб.": {Amount: 129950, Currency: CurrencyRUB},
+		"R$ 37,99":      {Amount: 3799, Currency: CurrencyBRL},
+		"¥ 1,980":       {Amount: 198000, Currency: CurrencyJPY},
+		"Rp 109 999":    {Amount: 10999900, Currency: CurrencyIDR},
+	}
+
+	for formatted, money := range m {
+
+		if money.String() != formatted {
+			t.Error("format", money.String(), formatted)
+		}
+
+		parsed, err := ParseMoney(formatted, money.Currency)
+		if err != nil {
+			t.Error(err, formatted)
+		}
+		if parsed != money {
+			t.Error("parse", formatted, parsed.Amount, money.Amount)
+		}
+	}
+
+	_, err := ParseMoney("Free", CurrencyUSD)
+	if err != ErrInvalidPrice {
+		t.Error("free should not parse")
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+
+	rates := ExchangeRateTable{Base: CurrencyUSD, Rates: map[CurrencyCode]float64{CurrencyEUR: 0.8, CurrencyGBP: 0.5}}
+
+	money, err := NewMoney(1000, CurrencyEUR).Convert(CurrencyGBP, rates)
+	if err != nil || money.Amount != 625 || money.Currency != CurrencyGBP {
+		t.Error("convert", money, err)
+	}
+
+	_, err = NewMoney(1000, CurrencyEUR).Convert(CurrencyJPY, rates)
+	if err != ErrMissingRate {
+		t.Error("missing rate", err)
+	}
+}
@@ -0,0 +1,290 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type PlayerCountSample struct {
+	AppID   int       `json:"appid"`
+	Time    time.Time `json:"time"`
+	Players int       `json:"players"`
+}
+
+// PlayerCountSink stores samples taken by a PlayerCountSampler
+type PlayerCountSink interface {
+	Add(sample PlayerCountSample) error
+}
+
+// PlayerCountSampler polls GetNumberOfCurrentPlayers for a set of apps on an interval.
+// Calls are made one at a time, so the client's API rate limit is respected.
+type PlayerCountSampler struct {
+	client   *Client
+	sink     PlayerCountSink
+	interval time.Duration
+	onError  func(appID int, err error)
+
+	mu     sync.Mutex
+	appIDs []int
+}
+
+func NewPlayerCountSampler(client *Client, sink PlayerCountSink, interval time.Duration, appIDs ...int) *PlayerCountSampler {
+	return &PlayerCountSampler{
+		client:   client,
+		sink:     sink,
+		interval: interval,
+		appIDs:   appIDs,
+	}
+}
+
+// SetAppIDs replaces the apps to sample, taking effect from the next round
+func (s *PlayerCountSampler) SetAppIDs(appIDs []int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.appIDs = append([]int(nil), appIDs...)
+}
+
+// SetErrorHandler is called when an app fails to sample, the round carries on with the next app
+func (s *PlayerCountSampler) SetErrorHandler(handler func(appID int, err error)) {
+	s.onError = handler
+}
+
+// Sample takes one sample of every app.
+// API errors are passed to the error handler, sink errors stop the round.
+func (s *PlayerCountSampler) Sample(ctx context.Context) error {
+
+	s.mu.Lock()
+	appIDs := append([]int(nil), s.appIDs...)
+	s.mu.Unlock()
+
+	for _, appID := range appIDs {
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		players, err := s.client.GetNumberOfCurrentPlayers(appID)
+		if err != nil {
+			if s.onError != nil {
+				s.onError(appID, err)
+			}
+			continue
+		}
+
+		err = s.sink.Add(PlayerCountSample{AppID: appID, Time: time.Now(), Players: players})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var ErrInvalidInterval = errors.New("interval must be more than zero")
+
+// Run samples straight away and then on every interval, until the context is cancelled
+func (s *PlayerCountSampler) Run(ctx context.Context) error {
+
+	if s.interval <= 0 {
+		return ErrInvalidInterval
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sample(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// PlayerCountRing keeps the latest samples for each app in memory
+type PlayerCountRing struct {
+	size    int
+	mu      sync.RWMutex
+	samples map[int][]PlayerCountSample
+	next    map[int]int
+}
+
+func NewPlayerCountRing(size int) *PlayerCountRing {
+	if size < 1 {
+		size = 1
+	}
+	return &PlayerCountRing{
+		size:    size,
+		samples: map[int][]PlayerCountSample{},
+		next:    map[int]int{},
+	}
+}
+
+func (r *PlayerCountRing) Add(sample PlayerCountSample) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.samples[sample.AppID]) < r.size {
+		r.samples[sample.AppID] = append(r.samples[sample.AppID], sample)
+		return nil
+	}
+
+	i := r.next[sample.AppID]
+	r.samples[sample.AppID][i] = sample
+	r.next[sample.AppID] = (i + 1) % r.size
+
+	return nil
+}
+
+// Samples returns an app's samples, oldest first
+func (r *PlayerCountRing) Samples(appID int) (samples PlayerCountSamples) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i := r.next[appID]
+	samples = append(samples, r.samples[appID][i:]...)
+	samples = append(samples, r.samples[appID][:i]...)
+
+	return samples
+}
+
+// PlayerCountCSVWriter writes a row per sample of appid, unix time and players
+type PlayerCountCSVWriter struct {
+	mu     sync.Mutex
+	writer *csv.Writer
+}
+
+func NewPlayerCountCSVWriter(w io.Writer) *PlayerCountCSVWriter {
+	return &PlayerCountCSVWriter{writer: csv.NewWriter(w)}
+}
+
+func (w *PlayerCountCSVWriter) Add(sample PlayerCountSample) error {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	err := w.writer.Write([]string{
+		strconv.Itoa(sample.AppID),
+		strconv.FormatInt(sample.Time.Unix(), 10),
+		strconv.Itoa(sample.Players),
+	})
+	if err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// PlayerCountJSONWriter writes a JSON object per line for each sample
+type PlayerCountJSONWriter struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewPlayerCountJSONWriter(w io.Writer) *PlayerCountJSONWriter {
+	return &PlayerCountJSONWriter{encoder: json.NewEncoder(w)}
+}
+
+func (w *PlayerCountJSONWriter) Add(sample PlayerCountSample) error {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.encoder.Encode(sample)
+}
+
+// PlayerCountSamples are the samples of a single app, oldest first
+type PlayerCountSamples []PlayerCountSample
+
+// Peak returns the sample with the most players
+func (s PlayerCountSamples) Peak() (peak PlayerCountSample, ok bool) {
+
+	for k, v := range s {
+		if k == 0 || v.Players > peak.Players {
+			peak = v
+		}
+	}
+	return peak, len(s) > 0
+}
+
+type PlayerCountDay struct {
+	Day     time.Time // Midnight in the location the days were split by
+	Average float64
+	Peak    int
+	Samples int
+}
+
+// DailyAverages groups samples by day in a location, oldest day first
+func (s PlayerCountSamples) DailyAverages(location *time.Location) (days []PlayerCountDay) {
+
+	totals := map[time.Time]int{}
+	indexes := map[time.Time]int{}
+
+	for _, v := range s {
+
+		t := v.Time.In(location)
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+
+		i, ok := indexes[day]
+		if !ok {
+			i = len(days)
+			indexes[day] = i
+			days = append(days, PlayerCountDay{Day: day})
+		}
+
+		days[i].Samples++
+		totals[day] += v.Players
+		if v.Players > days[i].Peak {
+			days[i].Peak = v.Players
+		}
+	}
+
+	for k, v := range days {
+		days[k].Average = float64(totals[v.Day]) / float64(v.Samples)
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Day.Before(days[j].Day)
+	})
+
+	return days
+}
+
+// Delta compares the latest sample to the latest sample at least a duration older.
+// ok is false if there are no samples that old.
+func (s PlayerCountSamples) Delta(duration time.Duration) (delta int, ok bool) {
+
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	latest := s[len(s)-1]
+	cutoff := latest.Time.Add(-duration)
+
+	for i := len(s) - 2; i >= 0; i-- {
+		if !s[i].Time.After(cutoff) {
+			return latest.Players - s[i].Players, true
+		}
+	}
+
+	return 0, false
+}
+
+// Delta24h compares the latest sample to the one from a day before
+func (s PlayerCountSamples) Delta24h() (delta int, ok bool) {
+	return s.Delta(24 * time.Hour)
+}
@@ -0,0 +1,72 @@
+package steamapi
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPlayerCountRing(t *testing.T) {
+
+	start := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	ring := NewPlayerCountRing(3)
+	for i := 0; i < 5; i++ {
+		_ = ring.Add(PlayerCountSample{AppID: 440, Time: start.Add(time.Duration(i) * time.Hour), Players: i})
+	}
+
+	samples := ring.Samples(440)
+	if len(samples) != 3 || samples[0].Players != 2 || samples[2].Players != 4 {
+		t.Error("ring", samples)
+	}
+	if len(ring.Samples(730)) != 0 {
+		t.Error("missing app")
+	}
+}
+
+func TestPlayerCountSamples(t *testing.T) {
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	samples := PlayerCountSamples{
+		{AppID: 440, Time: start, Players: 100},
+		{AppID: 440, Time: start.Add(12 * time.Hour), Players: 300},
+		{AppID: 440, Time: start.Add(24 * time.Hour), Players: 150},
+		{AppID: 440, Time: start.Add(30 * time.Hour), Players: 250},
+	}
+
+	peak, ok := samples.Peak()
+	if !ok || peak.Players != 300 {
+		t.Error("peak", peak)
+	}
+
+	days := samples.DailyAverages(time.UTC)
+	if len(days) != 2 || days[0].Average != 200 || days[0].Peak != 300 || days[1].Samples != 2 {
+		t.Error("days", days)
+	}
+
+	delta, ok := samples.Delta24h()
+	if !ok || delta != 150 {
+		t.Error("delta", delta)
+	}
+
+	_, ok = samples[:2].Delta24h()
+	if ok {
+		t.Error("delta should not be ok")
+	}
+
+	var buf bytes.Buffer
+	err := NewPlayerCountCSVWriter(&buf).Add(samples[0])
+	if err != nil || buf.String() != "440,1609459200,100\n" {
+		t.Error("csv", buf.String(), err)
+	}
+}
+
+func TestPlayerCountSamplerInterval(t *testing.T) {
+
+	sampler := NewPlayerCountSampler(NewClient(), NewPlayerCountRing(1), 0, 440)
+	if err := sampler.Run(context.Background()); err != ErrInvalidInterval {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,210 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+)
+
+type PriceEventType string
+
+// noinspection GoUnusedConst
+const (
+	PriceEventDiscountStarted PriceEventType = "discount_started"
+	PriceEventDiscountChanged PriceEventType = "discount_changed"
+	PriceEventDiscountEnded   PriceEventType = "discount_ended"
+	PriceEventBasePrice       PriceEventType = "base_price" // The price before discounts changed
+	PriceEventRemoved         PriceEventType = "removed"    // No longer sold in the region
+)
+
+type PriceEvent struct {
+	Type   PriceEventType
+	AppID  uint
+	CC     ProductCC
+	Before RegionPrice // Empty if the price was not known
+	After  RegionPrice // Empty for PriceEventRemoved
+}
+
+// PriceTrackerState is everything the tracker needs to remember between runs
+type PriceTrackerState struct {
+	ChangeNumbers map[int]int                        `json:"change_numbers"` // App ID -> price change number
+	Prices        map[uint]map[ProductCC]RegionPrice `json:"prices"`
+}
+
+type PriceTrackerStore interface {
+	Load() (PriceTrackerState, error)
+	Save(PriceTrackerState) error
+}
+
+// PriceTrackerFileStore keeps the tracker state in a JSON file
+type PriceTrackerFileStore struct {
+	Path string
+}
+
+func (s PriceTrackerFileStore) Load() (state PriceTrackerState, err error) {
+
+	b, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+
+	err = json.Unmarshal(b, &state)
+	return state, err
+}
+
+func (s PriceTrackerFileStore) Save(state PriceTrackerState) error {
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.Path, b, 0644)
+}
+
+// PriceTracker uses the price change numbers from GetAppList to only fetch prices that have changed
+type PriceTracker struct {
+	client  *Client
+	store   PriceTrackerStore
+	ccs     []ProductCC
+	handler func(event PriceEvent)
+	channel chan<- PriceEvent
+}
+
+func NewPriceTracker(client *Client, store PriceTrackerStore, ccs []ProductCC) *PriceTracker {
+	return &PriceTracker{
+		client: client,
+		store:  store,
+		ccs:    ccs,
+	}
+}
+
+func (t *PriceTracker) SetHandler(handler func(event PriceEvent)) {
+	t.handler = handler
+}
+
+// SetChannel sends events to a channel, the channel is not closed by the tracker.
+// Run blocks until each event is received, so use a buffered channel or read it from another goroutine.
+func (t *PriceTracker) SetChannel(channel chan<- PriceEvent) {
+	t.channel = channel
+}
+
+// Run checks for changes since the last run and emits an event for every price change.
+// The first run only records the current change numbers. When the price before a change is
+// not known, only a discount starting is reported.
+func (t *PriceTracker) Run() error {
+
+	state, err := t.store.Load()
+	if err != nil {
+		return err
+	}
+
+	first := state.ChangeNumbers == nil
+
+	if state.ChangeNumbers == nil {
+		state.ChangeNumbers = map[int]int{}
+	}
+	if state.Prices == nil {
+		state.Prices = map[uint]map[ProductCC]RegionPrice{}
+	}
+
+	var changed []uint
+
+	err = t.client.WalkAppList(AppListOptions{PageSize: 50000}, func(app AppListApp) bool {
+
+		last, ok := state.ChangeNumbers[app.AppID]
+		if !first && (!ok || last != app.PriceChangeNumber) {
+			changed = append(changed, uint(app.AppID))
+		}
+
+		state.ChangeNumbers[app.AppID] = app.PriceChangeNumber
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(changed) > 0 {
+
+		matrix, err := t.client.GetPriceMatrix(changed, t.ccs)
+		if err != nil {
+			return err
+		}
+
+		for _, appID := range changed {
+
+			prices := matrix[appID]
+
+			for _, event := range compareRegions(appID, state.Prices[appID], prices) {
+				t.emit(event)
+			}
+
+			if len(prices) == 0 {
+				delete(state.Prices, appID)
+			} else {
+				state.Prices[appID] = prices
+			}
+		}
+	}
+
+	return t.store.Save(state)
+}
+
+func (t *PriceTracker) emit(event PriceEvent) {
+
+	if t.handler != nil {
+		t.handler(event)
+	}
+	if t.channel != nil {
+		t.channel <- event
+	}
+}
+
+func compareRegions(appID uint, before map[ProductCC]RegionPrice, after map[ProductCC]RegionPrice) (events []PriceEvent) {
+
+	for cc, price := range after {
+		events = append(events, comparePrices(appID, cc, before[cc], price)...)
+	}
+
+	// Regions the app is no longer sold in
+	for cc, price := range before {
+		if _, ok := after[cc]; !ok {
+			events = append(events, PriceEvent{Type: PriceEventRemoved, AppID: appID, CC: cc, Before: price})
+		}
+	}
+
+	return events
+}
+
+func comparePrices(appID uint, cc ProductCC, before RegionPrice, after RegionPrice) (events []PriceEvent) {
+
+	newEvent := func(eventType PriceEventType) PriceEvent {
+		return PriceEvent{Type: eventType, AppID: appID, CC: cc, Before: before, After: after}
+	}
+
+	// Not known before, a discount is the only change worth reporting
+	if before.CC == "" {
+		if after.DiscountPercent > 0 {
+			events = append(events, newEvent(PriceEventDiscountStarted))
+		}
+		return events
+	}
+
+	switch {
+	case before.DiscountPercent == 0 && after.DiscountPercent > 0:
+		events = append(events, newEvent(PriceEventDiscountStarted))
+	case before.DiscountPercent > 0 && after.DiscountPercent == 0:
+		events = append(events, newEvent(PriceEventDiscountEnded))
+	case before.DiscountPercent != after.DiscountPercent:
+		events = append(events, newEvent(PriceEventDiscountChanged))
+	}
+
+	if before.Initial > 0 && before.Initial != after.Initial {
+		events = append(events, newEvent(PriceEventBasePrice))
+	}
+
+	return events
+}
@@ -0,0 +1,67 @@
+package steamapi
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestComparePrices(t *testing.T) {
+
+	full := RegionPrice{CC: ProductCCUS, Initial: 1999, Final: 1999}
+	sale := RegionPrice{CC: ProductCCUS, Initial: 1999, Final: 999, DiscountPercent: 50}
+	raised := RegionPrice{CC: ProductCCUS, Initial: 2999, Final: 2999}
+
+	events := comparePrices(10, ProductCCUS, full, sale)
+	if len(events) != 1 || events[0].Type != PriceEventDiscountStarted {
+		t.Error("started", events)
+	}
+
+	events = comparePrices(10, ProductCCUS, sale, raised)
+	if len(events) != 2 || events[0].Type != PriceEventDiscountEnded || events[1].Type != PriceEventBasePrice {
+		t.Error("ended", events)
+	}
+
+	events = comparePrices(10, ProductCCUS, RegionPrice{}, full)
+	if len(events) != 0 {
+		t.Error("unknown", events)
+	}
+
+	events = comparePrices(10, ProductCCUS, RegionPrice{}, sale)
+	if len(events) != 1 || events[0].Type != PriceEventDiscountStarted {
+		t.Error("unknown sale", events)
+	}
+}
+
+func TestCompareRegions(t *testing.T) {
+
+	us := RegionPrice{CC: ProductCCUS, Initial: 1999, Final: 1999}
+	uk := RegionPrice{CC: ProductCCUK, Initial: 1599, Final: 1599}
+
+	events := compareRegions(10, map[ProductCC]RegionPrice{ProductCCUS: us, ProductCCUK: uk}, map[ProductCC]RegionPrice{ProductCCUS: us})
+	if len(events) != 1 || events[0].Type != PriceEventRemoved || events[0].CC != ProductCCUK || events[0].Before != uk {
+		t.Error("removed", events)
+	}
+}
+
+func TestPriceTrackerFileStore(t *testing.T) {
+
+	store := PriceTrackerFileStore{Path: filepath.Join(t.TempDir(), "state.json")}
+
+	state, err := store.Load()
+	if err != nil || state.ChangeNumbers != nil {
+		t.Fatal("empty", err)
+	}
+
+	state.ChangeNumbers = map[int]int{10: 5}
+	state.Prices = map[uint]map[ProductCC]RegionPrice{10: {ProductCCUS: {CC: ProductCCUS, Final: 999}}}
+
+	err = store.Save(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err = store.Load()
+	if err != nil || state.ChangeNumbers[10] != 5 || state.Prices[10][ProductCCUS].Final != 999 {
+		t.Error("load", state, err)
+	}
+}
@@ -0,0 +1,92 @@
+package steamapi
+
+import (
+	"strconv"
+)
+
+// The store only allows more than one app per appdetails call when filtering to price_overview
+const priceMatrixBatchSize = 100
+
+// GetPriceMatrix gets the price of every app in every region, using ProductCCs if no regions are given.
+// Apps that are free or not sold in a region are missing from that region.
+func (c *Client) GetPriceMatrix(appIDs []uint, ccs []ProductCC) (matrix PriceMatrix, err error) {
+
+	if len(ccs) == 0 {
+		ccs = ProductCCs
+	}
+
+	matrix = PriceMatrix{}
+
+	for _, cc := range ccs {
+		for start := 0; start < len(appIDs); start += priceMatrixBatchSize {
+
+			end := start + priceMatrixBatchSize
+			if end > len(appIDs) {
+				end = len(appIDs)
+			}
+
+			resp, err := c.GetAppDetailsMulti(appIDs[start:end], cc, LanguageEnglish, []string{"price_overview"})
+			if err != nil {
+				return matrix, err
+			}
+
+			for _, id := range appIDs[start:end] {
+
+				app, ok := resp[strconv.FormatUint(uint64(id), 10)]
+				if !ok || !app.Success || app.Data == nil || app.Data.PriceOverview == nil {
+					continue
+				}
+
+				price := app.Data.PriceOverview
+				currency, _ := GetCurrency(price.Currency)
+
+				if _, ok := matrix[id]; !ok {
+					matrix[id] = map[ProductCC]RegionPrice{}
+				}
+
+				matrix[id][cc] = RegionPrice{
+					CC:               cc,
+					Currency:         currency,
+					Initial:          price.Initial,
+					Final:            price.Final,
+					DiscountPercent:  price.DiscountPercent,
+					InitialFormatted: price.InitialFormatted,
+					FinalFormatted:   price.FinalFormatted,
+				}
+			}
+		}
+	}
+
+	return matrix, nil
+}
+
+// App ID -> region -> price
+type PriceMatrix map[uint]map[ProductCC]RegionPrice
+
+type RegionPrice struct {
+	CC               ProductCC
+	Currency         Currency
+	Initial          int // In cents
+	Final            int // In cents
+	DiscountPercent  int
+	InitialFormatted string
+	FinalFormatted   string
+}
+
+// GetRegions returns the prices of one app, in the order of ProductCCs
+func (m PriceMatrix) GetRegions(appID uint) (prices []RegionPrice) {
+	for _, cc := range ProductCCs {
+		if price, ok := m[appID][cc]; ok {
+			prices = append(prices, price)
+		}
+	}
+	return prices
+}
+
+func (p RegionPrice) GetInitial() Money {
+	return NewMoney(p.Initial, p.Currency.CurrencyCode)
+}
+
+func (p RegionPrice) GetFinal() Money {
+	return NewMoney(p.Final, p.Currency.CurrencyCode)
+}
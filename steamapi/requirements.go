@@ -0,0 +1,209 @@
+package steamapi
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	requirementItemRegex  = regexp.MustCompile(`(?is)<li>(.*?)</li>`)
+	requirementLabelRegex = regexp.MustCompile(`(?is)^\s*<strong>(.*?)</strong>(.*)$`)
+	requirementTagRegex   = regexp.MustCompile(`<[^>]*>`)
+	requirement64BitRegex = regexp.MustCompile(`(?i)64[- ]?(bit|бит)`)
+	requirementSizeRegex  = regexp.MustCompile(`(?i)(\d+(?:[.,]\d+)?)\s*(TB|GB|MB|To|Go|Mo|ТБ|ГБ|МБ)`)
+)
+
+type requirementField int
+
+const (
+	requirementOS requirementField = iota + 1
+	requirementProcessor
+	requirementMemory
+	requirementGraphics
+	requirementDirectX
+	requirementStorage
+	requirementNetwork
+	requirementSoundCard
+	requirementNotes
+)
+
+// Labels are lower case and without the colon
+var requirementLabels = map[string]requirementField{
+
+	// English
+	"os":               requirementOS,
+	"processor":        requirementProcessor,
+	"memory":           requirementMemory,
+	"graphics":         requirementGraphics,
+	"directx":          requirementDirectX,
+	"storage":          requirementStorage,
+	"hard drive":       requirementStorage,
+	"hard disk space":  requirementStorage,
+	"network":          requirementNetwork,
+	"sound card":       requirementSoundCard,
+	"additional notes": requirementNotes,
+
+	// German
+	"betriebssystem":          requirementOS,
+	"prozessor":               requirementProcessor,
+	"arbeitsspeicher":         requirementMemory,
+	"grafik":                  requirementGraphics,
+	"speicherplatz":           requirementStorage,
+	"netzwerk":                requirementNetwork,
+	"soundkarte":              requirementSoundCard,
+	"zusätzliche anmerkungen": requirementNotes,
+
+	// French
+	"système d'exploitation": requirementOS,
+	"processeur":             requirementProcessor,
+	"mémoire vive":           requirementMemory,
+	"graphiques":             requirementGraphics,
+	"espace disque":          requirementStorage,
+	"réseau":                 requirementNetwork,
+	"carte son":              requirementSoundCard,
+	"notes supplémentaires":  requirementNotes,
+
+	// Spanish
+	"so":                requirementOS,
+	"sistema operativo": requirementOS,
+	"procesador":        requirementProcessor,
+	"memoria":           requirementMemory,
+	"gráficos":          requirementGraphics,
+	"almacenamiento":    requirementStorage,
+	"red":               requirementNetwork,
+	"tarjeta de sonido": requirementSoundCard,
+	"notas adicionales": requirementNotes,
+
+	// Russian
+	"ос":                 requirementOS,
+	"процессор":          requirementProcessor,
+	"оперативная память": requirementMemory,
+	"видеокарта":         requirementGraphics,
+	"место на диске":     requirementStorage,
+	"сеть":               requirementNetwork,
+	"звуковая карта":     requirementSoundCard,
+	"дополнительно":      requirementNotes,
+}
+
+type SystemRequirements struct {
+	Minimum     SystemRequirement
+	Recommended SystemRequirement
+}
+
+type SystemRequirement struct {
+	OS            string
+	Processor     string
+	Memory        string
+	MemoryMB      int // 0 if it could not be read
+	Graphics      string
+	DirectX       string
+	Storage       string
+	StorageMB     int // 0 if it could not be read
+	Network       string
+	SoundCard     string
+	Notes         string
+	Requires64Bit bool
+	Other         map[string]string // Lines with labels that are not known
+}
+
+// Parse reads the fields out of the requirements HTML
+func (r AppRequirements) Parse() SystemRequirements {
+	return SystemRequirements{
+		Minimum:     ParseSystemRequirement(r.Minimum),
+		Recommended: ParseSystemRequirement(r.Recommended),
+	}
+}
+
+// ParseSystemRequirement reads one of the store's requirement lists, eg <ul class="bb_ul"><li><strong>OS:</strong> Windows 10</li></ul>
+func ParseSystemRequirement(s string) (req SystemRequirement) {
+
+	for _, item := range requirementItemRegex.FindAllStringSubmatch(s, -1) {
+
+		parts := requirementLabelRegex.FindStringSubmatch(item[1])
+		if parts == nil {
+
+			// Lines without a label, eg "Requires a 64-bit processor and operating system"
+			if requirement64BitRegex.MatchString(cleanRequirement(item[1])) {
+				req.Requires64Bit = true
+			}
+			continue
+		}
+
+		// Footnoted labels have an asterisk, eg "OS *:"
+		label := strings.TrimRight(cleanRequirement(parts[1]), ": *")
+		value := strings.TrimPrefix(cleanRequirement(parts[2]), ":")
+		value = strings.TrimSpace(value)
+
+		switch requirementLabels[strings.ToLower(label)] {
+		case requirementOS:
+			req.OS = value
+		case requirementProcessor:
+			req.Processor = value
+		case requirementMemory:
+			req.Memory = value
+			req.MemoryMB = parseRequirementSize(value)
+		case requirementGraphics:
+			req.Graphics = value
+		case requirementDirectX:
+			req.DirectX = value
+		case requirementStorage:
+			req.Storage = value
+			req.StorageMB = parseRequirementSize(value)
+		case requirementNetwork:
+			req.Network = value
+		case requirementSoundCard:
+			req.SoundCard = value
+		case requirementNotes:
+			req.Notes = value
+		default:
+			if req.Other == nil {
+				req.Other = map[string]string{}
+			}
+			req.Other[label] = value
+		}
+	}
+
+	return req
+}
+
+func cleanRequirement(s string) string {
+	s = requirementTagRegex.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Returns the first size in a string in megabytes
+func parseRequirementSize(s string) int {
+
+	match := requirementSizeRegex.FindStringSubmatch(s)
+	if match == nil {
+		return 0
+	}
+
+	// A comma is a decimal point in "1,5 GB" but a thousands separator in "4,096 MB"
+	number := match[1]
+	if i := strings.Index(number, ","); i > -1 {
+		if decimals := len(number) - i - 1; decimals == 1 || decimals == 2 {
+			number = strings.Replace(number, ",", ".", 1)
+		} else {
+			number = strings.Replace(number, ",", "", 1)
+		}
+	}
+
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0
+	}
+
+	switch strings.ToUpper(match[2]) {
+	case "TB", "TO", "ТБ":
+		f *= 1024 * 1024
+	case "GB", "GO", "ГБ":
+		f *= 1024
+	}
+
+	return int(math.Round(f))
+}
@@ -0,0 +1,40 @@
+package steamapi
+
+import (
+	"testing"
+)
+
+func TestParseSystemRequirement(t *testing.T) {
+
+	req := ParseSystemRequirement(`<strong>Minimum:</strong><br><ul class="bb_ul"><li>Requires a 64-bit processor and operating system<br></li><li><strong>OS:</strong> 64-bit Windows 7, 64-bit Windows 8 (8.1) or 64-bit Windows 10<br></li><li><strong>Processor:</strong> Intel CPU Core i5-2500K 3.3GHz<br></li><li><strong>Memory:</strong> 6 GB RAM<br></li><li><strong>Graphics:</strong> Nvidia GPU GeForce GTX 660<br></li><li><strong>DirectX:</strong> Version 11<br></li><li><strong>Storage:</strong> 35 GB available space<br></li><li><strong>VR Support:</strong> None<br></li><li><strong>Additional Notes:</strong> Smoke &amp; mirrors</li></ul>`)
+
+	if !req.Requires64Bit || req.OS != "64-bit Windows 7, 64-bit Windows 8 (8.1) or 64-bit Windows 10" {
+		t.Error("os", req.OS)
+	}
+	if req.MemoryMB != 6144 || req.StorageMB != 35840 || req.DirectX != "Version 11" {
+		t.Error("sizes", req.MemoryMB, req.StorageMB)
+	}
+	if req.Notes != "Smoke & mirrors" || req.Other["VR Support"] != "None" {
+		t.Error("notes", req.Notes, req.Other)
+	}
+
+	req = ParseSystemRequirement(`<ul class="bb_ul"><li>Supports Windows 10 64 times faster<br></li><li><strong>OS *:</strong> Windows 10<br></li><li><strong>Memory:</strong> 8 GB RAM</li></ul>`)
+	if req.OS != "Windows 10" || req.Other != nil || req.Requires64Bit {
+		t.Error("footnoted", req.OS, req.Other, req.Requires64Bit)
+	}
+
+	req = ParseSystemRequirement(`<ul class="bb_ul"><li><strong>Betriebssystem:</strong> Windows 10<br></li><li><strong>Arbeitsspeicher:</strong> 512 MB RAM<br></li><li><strong>Speicherplatz:</strong> 1,5 GB verfügbarer Speicherplatz</li></ul>`)
+	if req.OS != "Windows 10" || req.MemoryMB != 512 || req.StorageMB != 1536 {
+		t.Error("german", req)
+	}
+
+	req = ParseSystemRequirement(`<ul class="bb_ul"><li><strong>Memory:</strong> 4,096 MB RAM<br></li><li><strong>Storage:</strong> 2,048 MB available space</li></ul>`)
+	if req.MemoryMB != 4096 || req.StorageMB != 2048 {
+		t.Error("thousands", req)
+	}
+
+	req = ParseSystemRequirement(`<ul class="bb_ul"><li><strong>ОС:</strong> Windows 7<br></li><li><strong>Оперативная память:</strong> 8 ГБ ОЗУ</li></ul>`)
+	if req.OS != "Windows 7" || req.MemoryMB != 8192 {
+		t.Error("russian", req)
+	}
+}
@@ -0,0 +1,287 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ReviewScore returns Steam's 1 to 9 score and label for a set of reviews, using the same thresholds as the store.
+// Less than 10 reviews gets a score of 0, as Steam only shows the count.
+func ReviewScore(positive int, negative int) (score int, label string) {
+
+	total := positive + negative
+
+	switch {
+	case total == 0:
+		return 0, "No user reviews"
+	case total == 1:
+		return 0, "1 user review"
+	case total < 10:
+		return 0, strconv.Itoa(total) + " user reviews"
+	}
+
+	percent := float64(positive) / float64(total) * 100
+
+	switch {
+	case percent >= 95 && total >= 500:
+		return 9, "Overwhelmingly Positive"
+	case percent >= 80 && total >= 50:
+		return 8, "Very Positive"
+	case percent >= 80:
+		return 7, "Positive"
+	case percent >= 70:
+		return 6, "Mostly Positive"
+	case percent >= 40:
+		return 5, "Mixed"
+	case percent >= 20:
+		return 4, "Mostly Negative"
+	case total >= 500:
+		return 1, "Overwhelmingly Negative"
+	case total >= 50:
+		return 2, "Very Negative"
+	default:
+		return 3, "Negative"
+	}
+}
+
+type ReviewCounts struct {
+	Positive int
+	Negative int
+}
+
+func (c ReviewCounts) Total() int {
+	return c.Positive + c.Negative
+}
+
+// Ratio returns the fraction of reviews that are positive, from 0 to 1
+func (c ReviewCounts) Ratio() float64 {
+	if c.Total() == 0 {
+		return 0
+	}
+	return float64(c.Positive) / float64(c.Total())
+}
+
+func (c ReviewCounts) Score() (score int, label string) {
+	return ReviewScore(c.Positive, c.Negative)
+}
+
+func (c *ReviewCounts) add(votedUp bool) {
+	if votedUp {
+		c.Positive++
+	} else {
+		c.Negative++
+	}
+}
+
+type ReviewPeriod struct {
+	Start time.Time
+	ReviewCounts
+}
+
+// Upper bounds in hours of the default playtime buckets, the last bucket has no upper bound
+var ReviewPlaytimeBuckets = []int{1, 5, 10, 20, 50, 100}
+
+type ReviewPlaytimeBucket struct {
+	MinHours int
+	MaxHours int // 0 for no upper bound
+	ReviewCounts
+}
+
+// ReviewAnalytics collects reviews, add them one at a time, eg from WalkReviews
+type ReviewAnalytics struct {
+	AllTime     ReviewCounts
+	EarlyAccess ReviewCounts // Written during early access
+	Release     ReviewCounts // Written after early access, or for games without it
+
+	reviews []analyticsReview
+}
+
+type analyticsReview struct {
+	created  time.Time
+	votedUp  bool
+	playtime int // Minutes at the time of the review
+}
+
+func (a *ReviewAnalytics) Add(review Review) {
+
+	a.AllTime.add(review.VotedUp)
+
+	if review.WrittenDuringEarlyAccess {
+		a.EarlyAccess.add(review.VotedUp)
+	} else {
+		a.Release.add(review.VotedUp)
+	}
+
+	playtime := review.Author.PlaytimeAtReview
+	if playtime == 0 {
+		playtime = review.Author.PlaytimeForever
+	}
+
+	a.reviews = append(a.reviews, analyticsReview{
+		created:  time.Unix(review.TimestampCreated, 0).UTC(),
+		votedUp:  review.VotedUp,
+		playtime: playtime,
+	})
+}
+
+// Recent counts reviews created in the duration before now, Steam uses 30 days
+func (a ReviewAnalytics) Recent(now time.Time, duration time.Duration) (counts ReviewCounts) {
+
+	cutoff := now.Add(-duration)
+
+	for _, v := range a.reviews {
+		if v.created.After(cutoff) && !v.created.After(now) {
+			counts.add(v.votedUp)
+		}
+	}
+	return counts
+}
+
+// Daily groups reviews by the UTC day they were created, oldest first
+func (a ReviewAnalytics) Daily() []ReviewPeriod {
+	return a.group(func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	})
+}
+
+// Monthly groups reviews by the UTC month they were created, oldest first
+func (a ReviewAnalytics) Monthly() []ReviewPeriod {
+	return a.group(func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	})
+}
+
+func (a ReviewAnalytics) group(truncate func(time.Time) time.Time) (periods []ReviewPeriod) {
+
+	m := map[time.Time]*ReviewCounts{}
+
+	for _, v := range a.reviews {
+
+		start := truncate(v.created)
+		if _, ok := m[start]; !ok {
+			m[start] = &ReviewCounts{}
+		}
+		m[start].add(v.votedUp)
+	}
+
+	for start, counts := range m {
+		periods = append(periods, ReviewPeriod{Start: start, ReviewCounts: *counts})
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].Start.Before(periods[j].Start)
+	})
+
+	return periods
+}
+
+// Playtime groups reviews by hours played when the review was written.
+// Pass nil to use ReviewPlaytimeBuckets.
+func (a ReviewAnalytics) Playtime(bounds []int) (buckets []ReviewPlaytimeBucket) {
+
+	if bounds == nil {
+		bounds = ReviewPlaytimeBuckets
+	}
+
+	var min int
+	for _, max := range bounds {
+		buckets = append(buckets, ReviewPlaytimeBucket{MinHours: min, MaxHours: max})
+		min = max
+	}
+	buckets = append(buckets, ReviewPlaytimeBucket{MinHours: min})
+
+	for _, v := range a.reviews {
+
+		hours := v.playtime / 60
+
+		for k, bucket := range buckets {
+			if bucket.MaxHours == 0 || hours < bucket.MaxHours {
+				buckets[k].add(v.votedUp)
+				break
+			}
+		}
+	}
+
+	return buckets
+}
+
+func (c *Client) GetReviewHistogram(appID int, language LanguageCode) (histogram ReviewHistogram, err error) {
+
+	query := url.Values{}
+	query.Set("l", string(language))
+	query.Set("review_score_preference", "0")
+
+	b, err := c.getFromStore("appreviewhistogram/"+strconv.Itoa(appID), query)
+	if err != nil {
+		return histogram, err
+	}
+
+	if string(b) == "null" {
+		return histogram, ErrNullResponse
+	}
+
+	var resp ReviewHistogramResponse
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return histogram, err
+	}
+
+	return resp.Results, nil
+}
+
+type ReviewHistogramResponse struct {
+	Success int             `json:"success"`
+	Results ReviewHistogram `json:"results"`
+}
+
+type ReviewHistogram struct {
+	StartDate  int64                   `json:"start_date"`
+	EndDate    int64                   `json:"end_date"`
+	RollupType string                  `json:"rollup_type"` // week or month
+	Rollups    []ReviewHistogramRollup `json:"rollups"`
+	Recent     []ReviewHistogramRollup `json:"recent"` // Daily, for the last 30 days
+}
+
+type ReviewHistogramRollup struct {
+	Date                int64 `json:"date"`
+	RecommendationsUp   int   `json:"recommendations_up"`
+	RecommendationsDown int   `json:"recommendations_down"`
+}
+
+// Periods returns the rollups as periods, oldest first
+func (h ReviewHistogram) Periods() []ReviewPeriod {
+	return rollupPeriods(h.Rollups)
+}
+
+// RecentPeriods returns the daily rollups of the last 30 days, oldest first
+func (h ReviewHistogram) RecentPeriods() []ReviewPeriod {
+	return rollupPeriods(h.Recent)
+}
+
+// AllTime adds up the rollups
+func (h ReviewHistogram) AllTime() (counts ReviewCounts) {
+	for _, v := range h.Rollups {
+		counts.Positive += v.RecommendationsUp
+		counts.Negative += v.RecommendationsDown
+	}
+	return counts
+}
+
+func rollupPeriods(rollups []ReviewHistogramRollup) (periods []ReviewPeriod) {
+
+	for _, v := range rollups {
+		periods = append(periods, ReviewPeriod{
+			Start:        time.Unix(v.Date, 0).UTC(),
+			ReviewCounts: ReviewCounts{Positive: v.RecommendationsUp, Negative: v.RecommendationsDown},
+		})
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].Start.Before(periods[j].Start)
+	})
+
+	return periods
+}
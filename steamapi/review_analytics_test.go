@@ -0,0 +1,79 @@
+package steamapi
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReviewScore(t *testing.T) {
+
+	tests := []struct {
+		positive int
+		negative int
+		score    int
+		label    string
+	}{
+		{0, 0, 0, "No user reviews"},
+		{5, 0, 0, "5 user reviews"},
+		{960, 40, 9, "Overwhelmingly Positive"},
+		{480, 10, 8, "Very Positive"},
+		{45, 5, 8, "Very Positive"},
+		{48, 1, 7, "Positive"},
+		{75, 25, 6, "Mostly Positive"},
+		{50, 50, 5, "Mixed"},
+		{30, 70, 4, "Mostly Negative"},
+		{1, 9, 3, "Negative"},
+		{10, 90, 2, "Very Negative"},
+		{50, 950, 1, "Overwhelmingly Negative"},
+	}
+
+	for _, test := range tests {
+		score, label := ReviewScore(test.positive, test.negative)
+		if score != test.score || label != test.label {
+			t.Error(test.positive, test.negative, score, label)
+		}
+	}
+}
+
+func TestReviewAnalytics(t *testing.T) {
+
+	day := time.Date(2021, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	newReview := func(created time.Time, up bool, hours int, earlyAccess bool) (r Review) {
+		r.TimestampCreated = created.Unix()
+		r.VotedUp = up
+		r.Author.PlaytimeAtReview = hours * 60
+		r.WrittenDuringEarlyAccess = earlyAccess
+		return r
+	}
+
+	a := ReviewAnalytics{}
+	a.Add(newReview(day, true, 0, true))
+	a.Add(newReview(day, false, 3, true))
+	a.Add(newReview(day.Add(24*time.Hour), true, 200, false))
+	a.Add(newReview(day.Add(-60*24*time.Hour), true, 12, false))
+
+	if a.AllTime.Total() != 4 || a.EarlyAccess.Ratio() != 0.5 || a.Release.Positive != 2 {
+		t.Error("counts", a.AllTime, a.EarlyAccess, a.Release)
+	}
+
+	daily := a.Daily()
+	if len(daily) != 3 || daily[1].Total() != 2 || !daily[0].Start.Before(daily[1].Start) {
+		t.Error("daily", daily)
+	}
+
+	monthly := a.Monthly()
+	if len(monthly) != 3 || monthly[2].Start.Month() != time.April {
+		t.Error("monthly", monthly)
+	}
+
+	recent := a.Recent(day.Add(48*time.Hour), 30*24*time.Hour)
+	if recent.Total() != 3 {
+		t.Error("recent", recent)
+	}
+
+	buckets := a.Playtime(nil)
+	if len(buckets) != 7 || buckets[0].Total() != 1 || buckets[1].Total() != 1 || buckets[3].Total() != 1 || buckets[6].Total() != 1 {
+		t.Error("playtime", buckets)
+	}
+}
@@ -37,13 +37,14 @@ func NewClient() *Client {
 }
 
 type Client struct {
-	key             string
-	userAgent       string
-	logger          logger
-	client          *http.Client
-	apiBucket       *ratelimit.Bucket
-	storeBucket     *ratelimit.Bucket
-	communityBucket *ratelimit.Bucket
+	key              string
+	userAgent        string
+	communityCookies []*http.Cookie
+	logger           logger
+	client           *http.Client
+	apiBucket        *ratelimit.Bucket
+	storeBucket      *ratelimit.Bucket
+	communityBucket  *ratelimit.Bucket
 }
 
 func (c *Client) SetKey(key string) {
@@ -62,6 +63,11 @@ func (c *Client) SetUserAgent(userAgent string) {
 	c.userAgent = userAgent
 }
 
+// SetCommunityCookies sets cookies to send to the community, eg steamLoginSecure for endpoints that need a login
+func (c *Client) SetCommunityCookies(cookies []*http.Cookie) {
+	c.communityCookies = cookies
+}
+
 func (c *Client) SetAPIRateLimit(duration time.Duration, burst int64) {
 	c.apiBucket = ratelimit.NewBucket(duration, burst)
 }
@@ -75,6 +81,15 @@ func (c *Client) SetCommunityRateLimit(duration time.Duration, burst int64) {
 }
 
 func (c *Client) getFromAPI(path string, query url.Values, key bool) (b []byte, err error) {
+	return c.getFromAPIHost("https://api.steampowered.com/", path, query, key)
+}
+
+// Publisher only interfaces, the key set must be a publisher key
+func (c *Client) getFromPartnerAPI(path string, query url.Values) (b []byte, err error) {
+	return c.getFromAPIHost("https://partner.steam-api.com/", path, query, true)
+}
+
+func (c *Client) getFromAPIHost(host string, path string, query url.Values, key bool) (b []byte, err error) {
 
 	if c.key == "" && key {
 		return b, ErrMissingKey
@@ -90,7 +105,7 @@ func (c *Client) getFromAPI(path string, query url.Values, key bool) (b []byte,
 		query.Set("key", c.key)
 	}
 
-	b, code, _, err := c.get("https://api.steampowered.com/" + path + "?" + query.Encode())
+	b, code, _, err := c.get(host + path + "?" + query.Encode())
 	if err != nil {
 		return b, err
 	}
@@ -121,7 +136,7 @@ func (c *Client) getFromStore(path string, query url.Values) (b []byte, err erro
 	return b, err
 }
 
-func (c *Client) getFromCommunity(path string, query url.Values) (b []byte, url string, err error) {
+func (c *Client) getFromCommunity(path string, query url.Values) (b []byte, code int, url string, err error) {
 
 	if c.communityBucket != nil {
 		c.communityBucket.Wait(1)
@@ -131,11 +146,10 @@ func (c *Client) getFromCommunity(path string, query url.Values) (b []byte, url
 		path += "?" + query.Encode()
 	}
 
-	b, _, url, err = c.get("https://steamcommunity.com/" + path)
-	return b, url, err
+	return c.get("https://steamcommunity.com/"+path, c.communityCookies...)
 }
 
-func (c *Client) get(path string) (b []byte, code int, url string, err error) {
+func (c *Client) get(path string, cookies ...*http.Cookie) (b []byte, code int, url string, err error) {
 
 	req, err := http.NewRequest("GET", path, nil)
 	if err != nil {
@@ -144,6 +158,10 @@ func (c *Client) get(path string) (b []byte, code int, url string, err error) {
 
 	req.Header.Set("User-Agent", c.userAgent)
 
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+
 	response, err := c.client.Do(req)
 	if err != nil {
 		return b, code, url, err
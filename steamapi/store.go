@@ -1,6 +1,7 @@
 package steamapi
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"net/url"
@@ -68,13 +69,6 @@ func (c *Client) GetAppDetailsMulti(ids []uint, cc ProductCC, language LanguageC
 		return resp, ErrHTMLResponse
 	}
 
-	// Fix arrays that should be objects
-	bytesString = strings.Replace(bytesString, `{"success":true,"data":[]}`, `{"success":true}`, 1)
-	bytesString = strings.Replace(bytesString, `"pc_requirements":[]`, `"pc_requirements":{}`, 1)
-	bytesString = strings.Replace(bytesString, `"mac_requirements":[]`, `"mac_requirements":{}`, 1)
-	bytesString = strings.Replace(bytesString, `"linux_requirements":[]`, `"linux_requirements":{}`, 1)
-	b = []byte(bytesString)
-
 	// Unmarshal JSON
 	resp = map[string]AppDetails{}
 	err = json.Unmarshal(b, &resp)
@@ -83,127 +77,276 @@ func (c *Client) GetAppDetailsMulti(ids []uint, cc ProductCC, language LanguageC
 }
 
 type AppDetails struct {
-	Success bool `json:"success"`
-	Data    *struct {
-		Type                string        `json:"type"`
-		Name                string        `json:"name"`
-		AppID               int           `json:"steam_appid"`
-		RequiredAge         unmarshal.Int `json:"required_age"`
-		IsFree              bool          `json:"is_free"`
-		DLC                 []int         `json:"dlc"`
-		ControllerSupport   string        `json:"controller_support"`
-		DetailedDescription string        `json:"detailed_description"`
-		AboutTheGame        string        `json:"about_the_game"`
-		ShortDescription    string        `json:"short_description"`
-		Fullgame            struct {
-			AppID unmarshal.Int `json:"appid"`
-			Name  string        `json:"name"`
-		} `json:"fullgame"`
-		SupportedLanguages string `json:"supported_languages"`
-		Reviews            string `json:"reviews"`
-		HeaderImage        string `json:"header_image"`
-		Website            string `json:"website"`
-		PcRequirements     struct {
-			Minimum     string `json:"minimum"`
-			Recommended string `json:"recommended"`
-		} `json:"pc_requirements"`
-		MacRequirements struct {
-			Minimum     string `json:"minimum"`
-			Recommended string `json:"recommended"`
-		} `json:"mac_requirements"`
-		LinuxRequirements struct {
-			Minimum     string `json:"minimum"`
-			Recommended string `json:"recommended"`
-		} `json:"linux_requirements"`
-		LegalNotice          string   `json:"legal_notice"`
-		ExtUserAccountNotice string   `json:"ext_user_account_notice"`
-		DRMNotice            string   `json:"drm_notice"`
-		Developers           []string `json:"developers"`
-		Publishers           []string `json:"publishers"`
-		Demos                []struct {
-			AppID       unmarshal.Int `json:"appid"`
-			Description string        `json:"description"`
-		} `json:"demos"`
-		PriceOverview *struct {
-			Currency         CurrencyCode `json:"currency"`
-			Initial          int          `json:"initial"`
-			Final            int          `json:"final"`
-			DiscountPercent  int          `json:"discount_percent"`
-			InitialFormatted string       `json:"initial_formatted"`
-			FinalFormatted   string       `json:"final_formatted"`
-			RecurringSub     interface{}  `json:"recurring_sub"` // Either "false" or a sub id int
-			RecurringSubDesc string       `json:"recurring_sub_desc"`
-		} `json:"price_overview"`
-		Packages      []int `json:"packages"`
-		PackageGroups []struct {
-			Name                    string           `json:"name"`
-			Title                   string           `json:"title"`
-			Description             string           `json:"description"`
-			SelectionText           string           `json:"selection_text"`
-			SaveText                string           `json:"save_text"`
-			DisplayType             unmarshal.String `json:"display_type"`
-			IsRecurringSubscription unmarshal.Bool   `json:"is_recurring_subscription"`
-			Subs                    []struct {
-				PackageID                int            `json:"packageid"`
-				PercentSavingsText       string         `json:"percent_savings_text"`
-				PercentSavings           int            `json:"percent_savings"`
-				OptionText               string         `json:"option_text"`
-				OptionDescription        string         `json:"option_description"`
-				CanGetFreeLicense        unmarshal.Bool `json:"can_get_free_license"`
-				IsFreeLicense            bool           `json:"is_free_license"`
-				PriceInCentsWithDiscount int            `json:"price_in_cents_with_discount"`
-			} `json:"subs"`
-		} `json:"package_groups"`
-		Platforms struct {
-			Windows bool `json:"windows"`
-			Mac     bool `json:"mac"`
-			Linux   bool `json:"linux"`
-		} `json:"platforms"`
-		Metacritic struct {
-			Score int8   `json:"score"`
-			URL   string `json:"url"`
-		} `json:"metacritic"`
-		Categories  AppDetailsCategory `json:"categories"`
-		Genres      AppDetailsGenre    `json:"genres"`
-		Screenshots []struct {
-			ID            int    `json:"id"`
-			PathThumbnail string `json:"path_thumbnail"`
-			PathFull      string `json:"path_full"`
-		} `json:"screenshots"`
-		Movies []struct {
-			ID        int    `json:"id"`
-			Name      string `json:"name"`
-			Thumbnail string `json:"thumbnail"`
-			Webm      struct {
-				Num480 string `json:"480"`
-				Max    string `json:"max"`
-			} `json:"webm"`
-			Highlight bool `json:"highlight"`
-		} `json:"movies"`
-		Recommendations struct {
-			Total int `json:"total"`
-		} `json:"recommendations"`
-		Achievements struct {
-			Total       int `json:"total"`
-			Highlighted []struct {
-				Name string `json:"name"`
-				Path string `json:"path"`
-			} `json:"highlighted"`
-		} `json:"achievements"`
-		ReleaseDate struct {
-			ComingSoon bool   `json:"coming_soon"`
-			Date       string `json:"date"`
-		} `json:"release_date"`
-		SupportInfo struct {
-			URL   string `json:"url"`
-			Email string `json:"email"`
-		} `json:"support_info"`
-		Background         string `json:"background"`
-		ContentDescriptors struct {
-			IDs   interface{}
-			Notes interface{}
-		} `json:"content_descriptors"`
-	} `json:"data"`
+	Success bool            `json:"success"`
+	Data    *AppDetailsData `json:"data"`
+}
+
+// Apps with nothing to return for the filters, like free apps when filtering to price_overview, have an empty array for data
+func (a *AppDetails) UnmarshalJSON(b []byte) error {
+
+	var resp struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}
+
+	err := json.Unmarshal(b, &resp)
+	if err != nil {
+		return err
+	}
+
+	a.Success = resp.Success
+	a.Data = nil
+
+	if isEmptyJSON(resp.Data) {
+		return nil
+	}
+
+	a.Data = &AppDetailsData{}
+	return json.Unmarshal(resp.Data, a.Data)
+}
+
+type AppDetailsData struct {
+	Type                 string                `json:"type"`
+	Name                 string                `json:"name"`
+	AppID                int                   `json:"steam_appid"`
+	RequiredAge          unmarshal.Int         `json:"required_age"`
+	IsFree               bool                  `json:"is_free"`
+	DLC                  []int                 `json:"dlc"`
+	ControllerSupport    string                `json:"controller_support"`
+	DetailedDescription  string                `json:"detailed_description"`
+	AboutTheGame         string                `json:"about_the_game"`
+	ShortDescription     string                `json:"short_description"`
+	Fullgame             AppDetailsFullgame    `json:"fullgame"`
+	SupportedLanguages   string                `json:"supported_languages"`
+	Reviews              string                `json:"reviews"`
+	HeaderImage          string                `json:"header_image"`
+	Website              string                `json:"website"`
+	PcRequirements       AppRequirements       `json:"pc_requirements"`
+	MacRequirements      AppRequirements       `json:"mac_requirements"`
+	LinuxRequirements    AppRequirements       `json:"linux_requirements"`
+	LegalNotice          string                `json:"legal_notice"`
+	ExtUserAccountNotice string                `json:"ext_user_account_notice"`
+	DRMNotice            string                `json:"drm_notice"`
+	Developers           []string              `json:"developers"`
+	Publishers           []string              `json:"publishers"`
+	Demos                []AppDetailsDemo      `json:"demos"`
+	PriceOverview        *AppPriceOverview     `json:"price_overview"`
+	Packages             []int                 `json:"packages"`
+	PackageGroups        []AppPackageGroup     `json:"package_groups"`
+	Platforms            AppPlatforms          `json:"platforms"`
+	Metacritic           AppMetacritic         `json:"metacritic"`
+	Categories           AppDetailsCategory    `json:"categories"`
+	Genres               AppDetailsGenre       `json:"genres"`
+	Screenshots          []AppScreenshot       `json:"screenshots"`
+	Movies               []AppMovie            `json:"movies"`
+	Recommendations      AppRecommendations    `json:"recommendations"`
+	Achievements         AppAchievements       `json:"achievements"`
+	ReleaseDate          AppReleaseDate        `json:"release_date"`
+	SupportInfo          AppSupportInfo        `json:"support_info"`
+	Background           string                `json:"background"`
+	BackgroundRaw        string                `json:"background_raw"`
+	ContentDescriptors   AppContentDescriptors `json:"content_descriptors"`
+}
+
+type AppDetailsFullgame struct {
+	AppID unmarshal.Int `json:"appid"`
+	Name  string        `json:"name"`
+}
+
+// Requirements are HTML, Steam returns an empty array instead of an object when there are none
+type AppRequirements struct {
+	Minimum     string `json:"minimum"`
+	Recommended string `json:"recommended"`
+}
+
+func (r *AppRequirements) UnmarshalJSON(b []byte) error {
+
+	*r = AppRequirements{}
+
+	if isEmptyJSON(b) {
+		return nil
+	}
+
+	// Some apps have a single string instead of an object
+	if b[0] == '"' {
+		return json.Unmarshal(b, &r.Minimum)
+	}
+
+	type plain AppRequirements
+	return json.Unmarshal(b, (*plain)(r))
+}
+
+type AppDetailsDemo struct {
+	AppID       unmarshal.Int `json:"appid"`
+	Description string        `json:"description"`
+}
+
+type AppPriceOverview struct {
+	Currency         CurrencyCode    `json:"currency"`
+	Initial          int             `json:"initial"`
+	Final            int             `json:"final"`
+	DiscountPercent  int             `json:"discount_percent"`
+	InitialFormatted string          `json:"initial_formatted"`
+	FinalFormatted   string          `json:"final_formatted"`
+	RecurringSub     AppRecurringSub `json:"recurring_sub"`
+	RecurringSubDesc string          `json:"recurring_sub_desc"`
+}
+
+func (p AppPriceOverview) GetInitial() Money {
+	return NewMoney(p.Initial, p.Currency)
+}
+
+func (p AppPriceOverview) GetFinal() Money {
+	return NewMoney(p.Final, p.Currency)
+}
+
+// The package ID of a recurring subscription, Steam returns false when there is none
+type AppRecurringSub int
+
+func (s *AppRecurringSub) UnmarshalJSON(b []byte) error {
+
+	*s = 0
+
+	str := strings.Trim(string(b), `"`)
+	if str == "" || str == "false" || str == "null" {
+		return nil
+	}
+
+	i, err := strconv.Atoi(str)
+	if err != nil {
+		return err
+	}
+
+	*s = AppRecurringSub(i)
+	return nil
+}
+
+type AppPackageGroup struct {
+	Name                    string               `json:"name"`
+	Title                   string               `json:"title"`
+	Description             string               `json:"description"`
+	SelectionText           string               `json:"selection_text"`
+	SaveText                string               `json:"save_text"`
+	DisplayType             unmarshal.String     `json:"display_type"`
+	IsRecurringSubscription unmarshal.Bool       `json:"is_recurring_subscription"`
+	Subs                    []AppPackageGroupSub `json:"subs"`
+}
+
+type AppPackageGroupSub struct {
+	PackageID                int            `json:"packageid"`
+	PercentSavingsText       string         `json:"percent_savings_text"`
+	PercentSavings           int            `json:"percent_savings"`
+	OptionText               string         `json:"option_text"`
+	OptionDescription        string         `json:"option_description"`
+	CanGetFreeLicense        unmarshal.Bool `json:"can_get_free_license"`
+	IsFreeLicense            bool           `json:"is_free_license"`
+	PriceInCentsWithDiscount int            `json:"price_in_cents_with_discount"`
+}
+
+type AppPlatforms struct {
+	Windows bool `json:"windows"`
+	Mac     bool `json:"mac"`
+	Linux   bool `json:"linux"`
+}
+
+type AppMetacritic struct {
+	Score int8   `json:"score"`
+	URL   string `json:"url"`
+}
+
+type AppScreenshot struct {
+	ID            int    `json:"id"`
+	PathThumbnail string `json:"path_thumbnail"`
+	PathFull      string `json:"path_full"`
+}
+
+type AppMovie struct {
+	ID        int          `json:"id"`
+	Name      string       `json:"name"`
+	Thumbnail string       `json:"thumbnail"`
+	Webm      AppMovieURLs `json:"webm"`
+	MP4       AppMovieURLs `json:"mp4"`
+	Highlight bool         `json:"highlight"`
+}
+
+type AppMovieURLs struct {
+	Num480 string `json:"480"`
+	Max    string `json:"max"`
+}
+
+type AppRecommendations struct {
+	Total int `json:"total"`
+}
+
+type AppAchievements struct {
+	Total       int `json:"total"`
+	Highlighted []struct {
+		Name string `json:"name"`
+		Path string `json:"path"`
+	} `json:"highlighted"`
+}
+
+type AppReleaseDate struct {
+	ComingSoon bool   `json:"coming_soon"`
+	Date       string `json:"date"`
+}
+
+type AppSupportInfo struct {
+	URL   string `json:"url"`
+	Email string `json:"email"`
+}
+
+type AppContentDescriptors struct {
+	IDs   []int  `json:"ids"`
+	Notes string `json:"notes"`
+}
+
+// IDs can be null and notes can be null or missing
+func (d *AppContentDescriptors) UnmarshalJSON(b []byte) error {
+
+	*d = AppContentDescriptors{}
+
+	if isEmptyJSON(b) {
+		return nil
+	}
+
+	var resp struct {
+		IDs   []unmarshal.Int  `json:"ids"`
+		Notes unmarshal.String `json:"notes"`
+	}
+
+	err := json.Unmarshal(b, &resp)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range resp.IDs {
+		d.IDs = append(d.IDs, int(v))
+	}
+	d.Notes = string(resp.Notes)
+
+	return nil
+}
+
+// Returns true for null, empty arrays and empty objects
+func isEmptyJSON(b []byte) bool {
+
+	b = bytes.TrimSpace(b)
+
+	switch string(b) {
+	case "", "null", "[]", "{}":
+		return true
+	}
+
+	// Arrays with whitespace inside
+	if b[0] == '[' {
+		var arr []json.RawMessage
+		if json.Unmarshal(b, &arr) == nil && len(arr) == 0 {
+			return true
+		}
+	}
+
+	return false
 }
 
 type AppDetailsGenre []struct {
@@ -250,40 +393,51 @@ func (c *Client) GetPackageDetails(id uint, code ProductCC, language LanguageCod
 		return pack, ErrPackageNotFound // Package 0 does exist but the API does not return it
 	}
 
+	resp, err := c.GetPackageDetailsMulti([]uint{id}, code, language)
+	if err != nil {
+		return pack, err
+	}
+
 	idx := strconv.FormatUint(uint64(id), 10)
 
+	if !resp[idx].Success {
+		return pack, ErrPackageNotFound
+	}
+
+	return resp[idx], nil
+}
+
+func (c *Client) GetPackageDetailsMulti(ids []uint, code ProductCC, language LanguageCode) (resp map[string]PackageDetailsBody, err error) {
+
+	var stringIDs []string
+	for _, id := range ids {
+		stringIDs = append(stringIDs, strconv.FormatUint(uint64(id), 10))
+	}
+
 	query := url.Values{}
-	query.Set("packageids", idx)
+	query.Set("packageids", strings.Join(stringIDs, ","))
 	query.Set("cc", string(code))    // Price currency
 	query.Set("l", string(language)) // Text
 
 	b, err := c.getFromStore("api/packagedetails", query)
 	if err != nil {
-		return pack, err
+		return resp, err
 	}
 
 	var bytesString = string(b)
 
 	// Check invalid responses
 	if bytesString == "null" {
-		return pack, ErrNullResponse
+		return resp, ErrNullResponse
 	}
 	if strings.HasPrefix(strings.TrimSpace(bytesString), "<") {
-		return pack, ErrHTMLResponse
+		return resp, ErrHTMLResponse
 	}
 
 	// Unmarshal JSON
-	resp := map[string]PackageDetailsBody{}
+	resp = map[string]PackageDetailsBody{}
 	err = json.Unmarshal(b, &resp)
-	if err != nil {
-		return pack, err
-	}
-
-	if !resp[idx].Success {
-		return pack, ErrPackageNotFound
-	}
-
-	return resp[idx], nil
+	return resp, err
 }
 
 type PackageDetailsBody struct {
@@ -318,8 +472,12 @@ type PackageDetailsBody struct {
 }
 
 func (c *Client) GetTags() (tags Tags, err error) {
+	return c.GetTagsInLanguage(LanguageEnglish)
+}
+
+func (c *Client) GetTagsInLanguage(language LanguageCode) (tags Tags, err error) {
 
-	b, err := c.getFromStore("tagdata/populartags/english", url.Values{})
+	b, err := c.getFromStore("tagdata/populartags/"+string(language), url.Values{})
 	if err != nil {
 		return tags, err
 	}
@@ -361,19 +519,92 @@ type Tag struct {
 
 func (c *Client) GetReviews(appID int, language LanguageCode) (reviews ReviewsResponse, err error) {
 
+	payload := ReviewsPayload{
+		Languages: []LanguageCode{language},
+		Language:  language,
+	}
+
+	return c.GetReviewsPage(appID, payload)
+}
+
+// noinspection GoUnusedConst
+const (
+	ReviewsFilterRecent  = "recent"
+	ReviewsFilterUpdated = "updated"
+	ReviewsFilterAll     = "all" // By helpfulness, uses DayRange
+
+	ReviewsTypeAll      = "all"
+	ReviewsTypePositive = "positive"
+	ReviewsTypeNegative = "negative"
+
+	ReviewsPurchaseAll      = "all"
+	ReviewsPurchaseSteam    = "steam"
+	ReviewsPurchaseNonSteam = "non_steam_purchase"
+)
+
+// Empty fields use Steam's defaults
+type ReviewsPayload struct {
+	Filter                  string         // eg ReviewsFilterRecent, defaults to all
+	Languages               []LanguageCode // Review languages, defaults to all
+	Language                LanguageCode   // Text language of the response
+	ReviewType              string         // eg ReviewsTypePositive, defaults to all
+	PurchaseType            string         // eg ReviewsPurchaseSteam, defaults to all
+	DayRange                int            // Only with the all filter, up to 365
+	IncludeOfftopicActivity bool           // Include reviews from review bombs
+	NumPerPage              int            // Up to 100, Steam defaults to 20
+	Cursor                  string         // From the last page, defaults to the first page
+}
+
+func (p ReviewsPayload) values() url.Values {
+
+	or := func(s string, def string) string {
+		if s == "" {
+			return def
+		}
+		return s
+	}
+
 	query := url.Values{}
 	query.Set("json", "1")
-	query.Set("language", string(language))
-	query.Set("l", string(language))
-	query.Set("filter", "all")
-	query.Set("purchase_type", "all")
+	query.Set("filter", or(p.Filter, ReviewsFilterAll))
+	query.Set("review_type", or(p.ReviewType, ReviewsTypeAll))
+	query.Set("purchase_type", or(p.PurchaseType, ReviewsPurchaseAll))
+	query.Set("cursor", or(p.Cursor, "*"))
 	query.Set("date_range_type", "all")
-	query.Set("review_type", "all")
 	query.Set("start_date", "-1")
 	query.Set("end_date", "-1")
-	query.Set("cursor", "*")
 
-	b, err := c.getFromStore("appreviews/"+strconv.Itoa(appID), query)
+	var languages []string
+	for _, v := range p.Languages {
+		if v != "" {
+			languages = append(languages, string(v))
+		}
+	}
+	if len(languages) > 0 {
+		query.Set("language", strings.Join(languages, ","))
+	} else {
+		query.Set("language", "all")
+	}
+
+	if p.Language != "" {
+		query.Set("l", string(p.Language))
+	}
+	if p.DayRange > 0 {
+		query.Set("day_range", strconv.Itoa(p.DayRange))
+	}
+	if p.IncludeOfftopicActivity {
+		query.Set("filter_offtopic_activity", "0")
+	}
+	if p.NumPerPage > 0 {
+		query.Set("num_per_page", strconv.Itoa(p.NumPerPage))
+	}
+
+	return query
+}
+
+func (c *Client) GetReviewsPage(appID int, payload ReviewsPayload) (reviews ReviewsResponse, err error) {
+
+	b, err := c.getFromStore("appreviews/"+strconv.Itoa(appID), payload.values())
 	if err != nil {
 		return reviews, err
 	}
@@ -387,6 +618,38 @@ func (c *Client) GetReviews(appID int, language LanguageCode) (reviews ReviewsRe
 	return reviews, nil
 }
 
+// WalkReviews calls fn with every review, following the cursor until there are no more reviews or fn returns false.
+// Steam repeats the last cursor when there are no more pages, so a repeated cursor also stops the walk.
+func (c *Client) WalkReviews(appID int, payload ReviewsPayload, fn func(review Review) bool) error {
+
+	if payload.Cursor == "" {
+		payload.Cursor = "*"
+	}
+
+	seen := map[string]bool{}
+
+	for {
+		seen[payload.Cursor] = true
+
+		resp, err := c.GetReviewsPage(appID, payload)
+		if err != nil {
+			return err
+		}
+
+		for _, review := range resp.Reviews {
+			if !fn(review) {
+				return nil
+			}
+		}
+
+		if len(resp.Reviews) == 0 || resp.Cursor == "" || seen[resp.Cursor] {
+			return nil
+		}
+
+		payload.Cursor = resp.Cursor
+	}
+}
+
 type ReviewsResponse struct {
 	Success      int `json:"success"`
 	QuerySummary struct {
@@ -397,101 +660,45 @@ type ReviewsResponse struct {
 		TotalNegative   int     `json:"total_negative"`
 		TotalReviews    int     `json:"total_reviews"`
 	} `json:"query_summary"`
-	Reviews []struct {
-		RecommendationID string `json:"recommendationid"`
-		Author           struct {
-			SteamID              unmarshal.Int64 `json:"steamid"`
-			NumGamesOwned        int             `json:"num_games_owned"`
-			NumReviews           int             `json:"num_reviews"`
-			PlaytimeForever      int             `json:"playtime_forever"`
-			PlaytimeLastTwoWeeks int             `json:"playtime_last_two_weeks"`
-			LastPlayed           int             `json:"last_played"`
-		} `json:"author"`
-		Language                 string            `json:"language"`
-		Review                   string            `json:"review"`
-		TimestampCreated         int64             `json:"timestamp_created"`
-		TimestampUpdated         int64             `json:"timestamp_updated"`
-		VotedUp                  bool              `json:"voted_up"`
-		VotesUp                  int               `json:"votes_up"`
-		VotesFunny               int               `json:"votes_funny"`
-		WeightedVoteScore        unmarshal.Float64 `json:"weighted_vote_score"`
-		CommentCount             int               `json:"comment_count"`
-		SteamPurchase            bool              `json:"steam_purchase"`
-		ReceivedForFree          bool              `json:"received_for_free"`
-		WrittenDuringEarlyAccess bool              `json:"written_during_early_access"`
-	} `json:"reviews"`
+	Reviews []Review `json:"reviews"`
+	Cursor  string   `json:"cursor"`
+}
+
+type Review struct {
+	RecommendationID string `json:"recommendationid"`
+	Author           struct {
+		SteamID              unmarshal.Int64 `json:"steamid"`
+		NumGamesOwned        int             `json:"num_games_owned"`
+		NumReviews           int             `json:"num_reviews"`
+		PlaytimeForever      int             `json:"playtime_forever"`
+		PlaytimeLastTwoWeeks int             `json:"playtime_last_two_weeks"`
+		PlaytimeAtReview     int             `json:"playtime_at_review"`
+		LastPlayed           int             `json:"last_played"`
+	} `json:"author"`
+	Language                 string            `json:"language"`
+	Review                   string            `json:"review"`
+	TimestampCreated         int64             `json:"timestamp_created"`
+	TimestampUpdated         int64             `json:"timestamp_updated"`
+	VotedUp                  bool              `json:"voted_up"`
+	VotesUp                  int               `json:"votes_up"`
+	VotesFunny               int               `json:"votes_funny"`
+	WeightedVoteScore        unmarshal.Float64 `json:"weighted_vote_score"`
+	CommentCount             int               `json:"comment_count"`
+	SteamPurchase            bool              `json:"steam_purchase"`
+	ReceivedForFree          bool              `json:"received_for_free"`
+	WrittenDuringEarlyAccess bool              `json:"written_during_early_access"`
 }
 
 func (r ReviewsResponse) GetPositivePercent() float64 {
+	if r.QuerySummary.TotalReviews == 0 {
+		return 0
+	}
 	return float64(r.QuerySummary.TotalPositive) / float64(r.QuerySummary.TotalReviews) * 100
 }
 
 func (r ReviewsResponse) GetNegativePercent() float64 {
+	if r.QuerySummary.TotalReviews == 0 {
+		return 0
+	}
 	return float64(r.QuerySummary.TotalNegative) / float64(r.QuerySummary.TotalReviews) * 100
 }
-
-//func (c *Client) GetWishlist(playerID int64) (wishlist Wishlist, err error) {
-//
-//	query := url.Values{}
-//	query.Set("p", "0")
-//
-//	b, err := c.getFromStore("wishlist/profiles/"+strconv.FormatInt(playerID, 10)+"/wishlistdata", query)
-//	if err != nil {
-//		return wishlist, err
-//	}
-//
-//	// No items
-//	if strings.TrimSpace(string(b)) == "[]" {
-//		return wishlist, err
-//	}
-//
-//	// Check for fail response
-//	failResp := WishlistFail{}
-//	err = json.Unmarshal(b, &failResp)
-//	if err == nil && failResp.Success > 0 {
-//		return wishlist, ErrWishlistNotFound
-//	}
-//
-//	// Unmarshal JSON
-//	err = json.Unmarshal(b, &wishlist.Items)
-//	return wishlist, err
-//}
-//
-//type WishlistFail struct {
-//	Success int `json:"success"`
-//}
-//
-//type Wishlist struct {
-//	Items map[unmarshal.Int]WishlistItem
-//}
-//
-//type WishlistItem struct {
-//	Name           string          `json:"name"`
-//	Capsule        string          `json:"capsule"`
-//	ReviewScore    int             `json:"review_score"`
-//	ReviewDesc     string          `json:"review_desc"`
-//	ReviewsTotal   string          `json:"reviews_total"`
-//	ReviewsPercent int             `json:"reviews_percent"`
-//	ReleaseDate    unmarshal.Int64 `json:"release_date"`
-//	ReleaseString  string          `json:"release_string"`
-//	PlatformIcons  string          `json:"platform_icons"`
-//	Subs           []struct {
-//		ID            int           `json:"id"`
-//		DiscountBlock string        `json:"discount_block"`
-//		DiscountPct   int           `json:"discount_pct"`
-//		Price         unmarshal.Int `json:"price"`
-//	} `json:"subs"`
-//	Type        string         `json:"type"`
-//	Screenshots []string       `json:"screenshots"`
-//	ReviewCSS   string         `json:"review_css"`
-//	Priority    int            `json:"priority"`
-//	Added       int            `json:"added"`
-//	Background  string         `json:"background"`
-//	Rank        unmarshal.Int  `json:"rank"`
-//	Tags        []string       `json:"tags"`
-//	EarlyAccess int            `json:"early_access"`
-//	IsFreeGame  bool           `json:"is_free_game"`
-//	Win         unmarshal.Bool `json:"win"`
-//	Mac         unmarshal.Bool `json:"mac"`
-//	Linux       unmarshal.Bool `json:"linux"`
-//}
@@ -0,0 +1,247 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/unmarshal-go"
+)
+
+// noinspection GoUnusedConst
+const (
+	StoreSearchSortRelevance    = "_ASC"
+	StoreSearchSortReleased     = "Released_DESC"
+	StoreSearchSortName         = "Name_ASC"
+	StoreSearchSortPriceLow     = "Price_ASC"
+	StoreSearchSortPriceHigh    = "Price_DESC"
+	StoreSearchSortReviews      = "Reviews_DESC"
+	StoreSearchOSWindows        = "win"
+	StoreSearchOSMac            = "mac"
+	StoreSearchOSLinux          = "linux"
+	StoreSearchMaxPriceFree     = "free"
+	StoreSearchCategoryGames    = 998
+	StoreSearchCategorySoftware = 994
+	StoreSearchCategoryDLC      = 21
+)
+
+type StoreSearchPayload struct {
+	Term       string
+	Tags       []int
+	Categories []int    // Item types, eg StoreSearchCategoryGames
+	Features   []int    // Store categories, eg 2 for single-player
+	OS         []string // eg StoreSearchOSWindows
+	MaxPrice   string   // StoreSearchMaxPriceFree or a whole number in the region's currency
+	Specials   bool     // Only discounted items
+	SortBy     string   // eg StoreSearchSortReleased
+	CC         ProductCC
+	Language   LanguageCode
+	Offset     int
+	Limit      int // Steam returns 50 when not set
+}
+
+func (p StoreSearchPayload) values() url.Values {
+
+	join := func(ints []int) string {
+		var s []string
+		for _, v := range ints {
+			s = append(s, strconv.Itoa(v))
+		}
+		return strings.Join(s, ",")
+	}
+
+	vals := url.Values{}
+	vals.Set("json", "1")
+
+	if p.Term != "" {
+		vals.Set("term", p.Term)
+	}
+	if len(p.Tags) > 0 {
+		vals.Set("tags", join(p.Tags))
+	}
+	if len(p.Categories) > 0 {
+		vals.Set("category1", join(p.Categories))
+	}
+	if len(p.Features) > 0 {
+		vals.Set("category2", join(p.Features))
+	}
+	if len(p.OS) > 0 {
+		vals.Set("os", strings.Join(p.OS, ","))
+	}
+	if p.MaxPrice != "" {
+		vals.Set("maxprice", p.MaxPrice)
+	}
+	if p.Specials {
+		vals.Set("specials", "1")
+	}
+	if p.SortBy != "" {
+		vals.Set("sort_by", p.SortBy)
+	}
+	if p.CC != "" {
+		vals.Set("cc", string(p.CC))
+	}
+	if p.Language != "" {
+		vals.Set("l", string(p.Language))
+	}
+	if p.Offset > 0 {
+		vals.Set("start", strconv.Itoa(p.Offset))
+	}
+	if p.Limit > 0 {
+		vals.Set("count", strconv.Itoa(p.Limit))
+	}
+
+	return vals
+}
+
+func (c *Client) GetStoreSearch(payload StoreSearchPayload) (resp StoreSearch, err error) {
+
+	b, err := c.getFromStore("search/results/", payload.values())
+	if err != nil {
+		return resp, err
+	}
+
+	if strings.HasPrefix(string(b), "<") {
+		return resp, ErrHTMLResponse
+	}
+
+	err = json.Unmarshal(b, &resp)
+	return resp, err
+}
+
+// WalkStoreSearch calls fn with every search result, paging until there are no more results or fn returns false
+func (c *Client) WalkStoreSearch(payload StoreSearchPayload, fn func(item StoreSearchItem) bool) error {
+
+	if payload.Limit < 1 {
+		payload.Limit = 50
+	}
+
+	for {
+		resp, err := c.GetStoreSearch(payload)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range resp.Items {
+			if !fn(item) {
+				return nil
+			}
+		}
+
+		if len(resp.Items) < payload.Limit {
+			return nil
+		}
+
+		payload.Offset += len(resp.Items)
+	}
+}
+
+type StoreSearch struct {
+	Desc  string            `json:"desc"`
+	Items []StoreSearchItem `json:"items"`
+}
+
+type StoreSearchItem struct {
+	Name string `json:"name"`
+	Logo string `json:"logo"`
+}
+
+var storeSearchLogoRegex = regexp.MustCompile(`/(apps|subs|bundles)/([0-9]+)/`)
+
+// GetID returns the app, sub or bundle ID from the logo URL, the only place the search results have it
+func (i StoreSearchItem) GetID() (itemType string, id int) {
+
+	match := storeSearchLogoRegex.FindStringSubmatch(i.Logo)
+	if match == nil {
+		return "", 0
+	}
+
+	id, _ = strconv.Atoi(match[2])
+	return strings.TrimSuffix(match[1], "s"), id
+}
+
+func (c *Client) GetFeatured(cc ProductCC, language LanguageCode) (resp Featured, err error) {
+
+	query := url.Values{}
+	query.Set("cc", string(cc))
+	query.Set("l", string(language))
+
+	b, err := c.getFromStore("api/featured/", query)
+	if err != nil {
+		return resp, err
+	}
+
+	if string(b) == "null" {
+		return resp, ErrNullResponse
+	}
+
+	err = json.Unmarshal(b, &resp)
+	return resp, err
+}
+
+type Featured struct {
+	LargeCapsules []FeaturedItem `json:"large_capsules"`
+	FeaturedWin   []FeaturedItem `json:"featured_win"`
+	FeaturedMac   []FeaturedItem `json:"featured_mac"`
+	FeaturedLinux []FeaturedItem `json:"featured_linux"`
+	Layout        string         `json:"layout"`
+	Status        int            `json:"status"`
+}
+
+type FeaturedItem struct {
+	ID                      int           `json:"id"`
+	Type                    int           `json:"type"` // 0 app, 1 package
+	Name                    string        `json:"name"`
+	Discounted              bool          `json:"discounted"`
+	DiscountPercent         int           `json:"discount_percent"`
+	OriginalPrice           unmarshal.Int `json:"original_price"` // Can be null
+	FinalPrice              int           `json:"final_price"`
+	Currency                CurrencyCode  `json:"currency"`
+	LargeCapsuleImage       string        `json:"large_capsule_image"`
+	SmallCapsuleImage       string        `json:"small_capsule_image"`
+	HeaderImage             string        `json:"header_image"`
+	WindowsAvailable        bool          `json:"windows_available"`
+	MacAvailable            bool          `json:"mac_available"`
+	LinuxAvailable          bool          `json:"linux_available"`
+	StreamingVideoAvailable bool          `json:"streamingvideo_available"`
+	ControllerSupport       string        `json:"controller_support"`
+	DiscountExpiration      int64         `json:"discount_expiration"`
+}
+
+func (i FeaturedItem) GetFinal() Money {
+	return NewMoney(i.FinalPrice, i.Currency)
+}
+
+func (c *Client) GetFeaturedCategories(cc ProductCC, language LanguageCode) (resp FeaturedCategories, err error) {
+
+	query := url.Values{}
+	query.Set("cc", string(cc))
+	query.Set("l", string(language))
+
+	b, err := c.getFromStore("api/featuredcategories/", query)
+	if err != nil {
+		return resp, err
+	}
+
+	if string(b) == "null" {
+		return resp, ErrNullResponse
+	}
+
+	err = json.Unmarshal(b, &resp)
+	return resp, err
+}
+
+type FeaturedCategories struct {
+	Specials    FeaturedCategory `json:"specials"`
+	ComingSoon  FeaturedCategory `json:"coming_soon"`
+	TopSellers  FeaturedCategory `json:"top_sellers"`
+	NewReleases FeaturedCategory `json:"new_releases"`
+	Status      int              `json:"status"`
+}
+
+type FeaturedCategory struct {
+	ID    string         `json:"id"`
+	Name  string         `json:"name"`
+	Items []FeaturedItem `json:"items"`
+}
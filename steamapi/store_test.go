@@ -1,18 +1,23 @@
 package steamapi
 
 import (
+	"encoding/json"
+	"flag"
+	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
-//func TestWishlist(t *testing.T) {
-//
-//	c := NewClient()
-//
-//	_, err := c.GetWishlist(76561198004579722)
-//	if err != nil {
-//		t.Error(err)
-//	}
-//}
+func TestWishlist(t *testing.T) {
+
+	c := NewClient()
+
+	_, err := c.GetWishlist(76561198004579722)
+	if err != nil && err != ErrWishlistNotFound {
+		t.Error(err)
+	}
+}
 
 func TestApps(t *testing.T) {
 
@@ -54,3 +59,157 @@ func TestApps(t *testing.T) {
 		t.Error("price should be nil")
 	}
 }
+
+var updateAppDetails = flag.Bool("update", false, "capture api/appdetails responses into testdata/appdetails")
+
+// Real responses, saved unedited by running: go test -run TestAppDetailsDecoding -update
+var appDetailsCaptures = map[string]url.Values{
+	"440":                   {"appids": {"440"}},
+	"292030":                {"appids": {"292030"}},
+	"355880_dlc":            {"appids": {"355880"}},
+	"252490_570_1_filtered": {"appids": {"252490,570,1"}, "filters": {"price_overview"}, "cc": {"us"}},
+}
+
+func TestAppDetailsDecoding(t *testing.T) {
+
+	if *updateAppDetails {
+
+		c := NewClient()
+
+		err := os.MkdirAll(filepath.Join("testdata", "appdetails"), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for name, query := range appDetailsCaptures {
+
+			b, err := c.getFromStore("api/appdetails", query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = os.WriteFile(filepath.Join("testdata", "appdetails", name+".json"), b, 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	files, _ := filepath.Glob("testdata/appdetails/*.json")
+	if len(files) == 0 {
+		t.Skip("no captured responses, run with -update")
+	}
+
+	apps := map[string]AppDetails{}
+
+	for _, file := range files {
+
+		b, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp := map[string]AppDetails{}
+		err = json.Unmarshal(b, &resp)
+		if err != nil {
+			t.Error(file, err)
+		}
+
+		for k, v := range resp {
+			apps[k] = v
+		}
+	}
+
+	if apps["1"].Success {
+		t.Error("1 should fail")
+	}
+
+	// Free apps have an empty data array when filtered to price_overview
+	if app, ok := apps["570"]; !ok || !app.Success || app.Data != nil {
+		t.Error("570 data should be nil")
+	}
+
+	if tf2 := apps["440"].Data; tf2 == nil || tf2.Name != "Team Fortress 2" || len(tf2.Movies) == 0 || tf2.Movies[0].MP4.Max == "" {
+		t.Error("440")
+	}
+
+	if rust := apps["252490"].Data; rust == nil || rust.PriceOverview == nil || rust.PriceOverview.GetFinal().String() != rust.PriceOverview.FinalFormatted {
+		t.Error("252490 price")
+	}
+
+	if witcher := apps["292030"].Data; witcher == nil || witcher.RequiredAge == 0 || len(witcher.ContentDescriptors.IDs) == 0 {
+		t.Error("292030")
+	}
+
+	dlc := apps["355880"].Data
+	if dlc == nil || dlc.Type != "dlc" || dlc.Fullgame.AppID != 292030 {
+		t.Error("355880")
+	} else if dlc.PriceOverview == nil || dlc.PriceOverview.GetFinal().String() != dlc.PriceOverview.FinalFormatted {
+		t.Error("355880 price")
+	}
+}
+
+func TestAppDetailsQuirks(t *testing.T) {
+
+	// One response with several apps that used to break decoding after the first
+	var multi map[string]AppDetails
+	err := json.Unmarshal([]byte(`{
+		"10": {"success": true, "data": []},
+		"20": {"success": true, "data": {"name": "A", "pc_requirements": [], "mac_requirements": [], "linux_requirements": [],
+			"price_overview": {"currency": "USD", "initial": 3999, "final": 1599, "discount_percent": 60, "final_formatted": "$15.99", "recurring_sub": false}}},
+		"30": {"success": true, "data": []},
+		"40": {"success": true, "data": {"name": "B", "pc_requirements": [], "mac_requirements": [], "linux_requirements": [],
+			"price_overview": {"currency": "EUR", "initial": 2499, "final": 2499, "final_formatted": "24,99€", "recurring_sub": 403580},
+			"movies": [{"id": 1, "mp4": {"480": "a.mp4", "max": "b.mp4"}}]}},
+		"50": {"success": false}
+	}`), &multi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(multi) != 5 || multi["10"].Data != nil || multi["30"].Data != nil || !multi["30"].Success || multi["50"].Success {
+		t.Error("multi", multi)
+	}
+
+	a, b := multi["20"].Data, multi["40"].Data
+	if a == nil || b == nil {
+		t.Fatal("multi data")
+	}
+	if a.PcRequirements.Minimum != "" || b.LinuxRequirements.Minimum != "" {
+		t.Error("requirements")
+	}
+	if a.PriceOverview == nil || a.PriceOverview.GetFinal().String() != "$15.99" || a.PriceOverview.RecurringSub != 0 {
+		t.Error("usd price")
+	}
+	if b.PriceOverview == nil || b.PriceOverview.GetFinal().String() != "24,99€" || b.PriceOverview.RecurringSub != 403580 {
+		t.Error("eur price")
+	}
+	if len(b.Movies) != 1 || b.Movies[0].MP4.Max != "b.mp4" {
+		t.Error("movies")
+	}
+
+	var app AppDetails
+	err = json.Unmarshal([]byte(`{"success":true,"data":[]}`), &app)
+	if err != nil || !app.Success || app.Data != nil {
+		t.Error("empty data", err)
+	}
+
+	var data AppDetailsData
+	err = json.Unmarshal([]byte(`{"required_age":"18","mac_requirements":[],"linux_requirements":"<strong>Minimum:</strong>","content_descriptors":{"ids":null,"notes":null}}`), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.RequiredAge != 18 || data.MacRequirements.Minimum != "" || data.LinuxRequirements.Minimum == "" || data.ContentDescriptors.IDs != nil {
+		t.Error("data", data)
+	}
+
+	var price AppPriceOverview
+	err = json.Unmarshal([]byte(`{"currency":"USD","final":999,"recurring_sub":false}`), &price)
+	if err != nil || price.RecurringSub != 0 || price.GetFinal().String() != "$9.99" {
+		t.Error("recurring false", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"currency":"USD","final":999,"recurring_sub":12345}`), &price)
+	if err != nil || price.RecurringSub != 12345 {
+		t.Error("recurring id", err)
+	}
+}
@@ -0,0 +1,166 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrAgeCheck = errors.New("steam: store: age check")
+
+// TagCatalog holds every popular tag with its name in each language
+type TagCatalog struct {
+	tags  map[int]*CatalogTag
+	names map[string]int // Lower case name in any language -> tag ID
+	slugs map[string]int
+	order []int
+}
+
+type CatalogTag struct {
+	TagID int
+	Slug  string // From the English name, eg "open-world"
+	Names map[LanguageCode]string
+}
+
+// GetName returns the tag's name in a language, falling back to English
+func (t CatalogTag) GetName(language LanguageCode) string {
+	if name, ok := t.Names[language]; ok {
+		return name
+	}
+	return t.Names[LanguageEnglish]
+}
+
+func NewTagCatalog() *TagCatalog {
+	return &TagCatalog{
+		tags:  map[int]*CatalogTag{},
+		names: map[string]int{},
+		slugs: map[string]int{},
+	}
+}
+
+// GetTagCatalog gets the popular tags in each language, using LanguageCodes if no languages are given.
+// English is always fetched so every tag has a slug.
+func (c *Client) GetTagCatalog(languages []LanguageCode) (catalog *TagCatalog, err error) {
+
+	if len(languages) == 0 {
+		languages = LanguageCodes
+	}
+
+	languages = append([]LanguageCode{LanguageEnglish}, languages...)
+
+	catalog = NewTagCatalog()
+	done := map[LanguageCode]bool{}
+
+	for _, language := range languages {
+
+		if done[language] {
+			continue
+		}
+		done[language] = true
+
+		tags, err := c.GetTagsInLanguage(language)
+		if err != nil {
+			return catalog, err
+		}
+
+		catalog.Add(language, tags.Tags)
+	}
+
+	return catalog, nil
+}
+
+// Add merges in the tags from one language
+func (t *TagCatalog) Add(language LanguageCode, tags []Tag) {
+
+	for _, v := range tags {
+
+		tag, ok := t.tags[v.TagID]
+		if !ok {
+			tag = &CatalogTag{TagID: v.TagID, Names: map[LanguageCode]string{}}
+			t.tags[v.TagID] = tag
+			t.order = append(t.order, v.TagID)
+		}
+
+		tag.Names[language] = v.Name
+		t.names[strings.ToLower(v.Name)] = v.TagID
+
+		if language == LanguageEnglish {
+			tag.Slug = TagSlug(v.Name)
+			t.slugs[tag.Slug] = v.TagID
+		}
+	}
+}
+
+func (t *TagCatalog) ByID(id int) (tag CatalogTag, ok bool) {
+	if v, ok := t.tags[id]; ok {
+		return *v, true
+	}
+	return tag, false
+}
+
+// ByName finds a tag by its name in any language, ignoring case
+func (t *TagCatalog) ByName(name string) (tag CatalogTag, ok bool) {
+	if id, ok := t.names[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return t.ByID(id)
+	}
+	return tag, false
+}
+
+func (t *TagCatalog) BySlug(slug string) (tag CatalogTag, ok bool) {
+	if id, ok := t.slugs[slug]; ok {
+		return t.ByID(id)
+	}
+	return tag, false
+}
+
+// Tags returns every tag, in order of popularity in the first language added
+func (t *TagCatalog) Tags() (tags []CatalogTag) {
+	for _, id := range t.order {
+		tags = append(tags, *t.tags[id])
+	}
+	return tags
+}
+
+var tagSlugRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// TagSlug makes a URL safe version of a tag name, eg "Rogue-like" and "Rogue like" both become "rogue-like"
+func TagSlug(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", "and")
+	return strings.Trim(tagSlugRegex.ReplaceAllString(name, "-"), "-")
+}
+
+var appTagsRegex = regexp.MustCompile(`InitAppTagModal\(\s*[0-9]+,\s*(\[.*?\])\s*,`)
+
+// GetAppTags gets the user tags on an app and how many users applied each one, most votes first.
+// Apps behind an age check return ErrAgeCheck.
+func (c *Client) GetAppTags(appID int) (tags []AppTag, err error) {
+
+	query := url.Values{}
+	query.Set("l", string(LanguageEnglish))
+
+	b, err := c.getFromStore("app/"+strconv.Itoa(appID)+"/", query)
+	if err != nil {
+		return tags, err
+	}
+
+	match := appTagsRegex.FindSubmatch(b)
+	if match == nil {
+		if strings.Contains(string(b), "agecheck") {
+			return tags, ErrAgeCheck
+		}
+		return tags, ErrAppNotFound
+	}
+
+	err = json.Unmarshal(match[1], &tags)
+	return tags, err
+}
+
+type AppTag struct {
+	TagID      int    `json:"tagid"`
+	Name       string `json:"name"`
+	Count      int    `json:"count"`
+	Browseable bool   `json:"browseable"`
+}
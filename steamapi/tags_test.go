@@ -0,0 +1,36 @@
+package steamapi
+
+import (
+	"testing"
+)
+
+func TestTagCatalog(t *testing.T) {
+
+	catalog := NewTagCatalog()
+	catalog.Add(LanguageEnglish, []Tag{{TagID: 19, Name: "Action"}, {TagID: 1695, Name: "Open World"}, {TagID: 1716, Name: "Rogue-like"}})
+	catalog.Add(LanguageGerman, []Tag{{TagID: 1695, Name: "Offene Spielwelt"}, {TagID: 19, Name: "Action"}})
+
+	tag, ok := catalog.ByName("offene spielwelt")
+	if !ok || tag.TagID != 1695 || tag.GetName(LanguageGerman) != "Offene Spielwelt" || tag.GetName(LanguageFrench) != "Open World" {
+		t.Error("name", tag)
+	}
+
+	tag, ok = catalog.BySlug("rogue-like")
+	if !ok || tag.TagID != 1716 {
+		t.Error("slug", tag)
+	}
+
+	_, ok = catalog.ByID(1)
+	if ok {
+		t.Error("missing id")
+	}
+
+	tags := catalog.Tags()
+	if len(tags) != 3 || tags[0].TagID != 19 {
+		t.Error("order", tags)
+	}
+
+	if TagSlug("Puzzle Platformer") != "puzzle-platformer" || TagSlug("Hack and Slash") != TagSlug("Hack & Slash") {
+		t.Error("TagSlug")
+	}
+}
@@ -0,0 +1,48 @@
+package steamlevel
+
+type Estimate struct {
+	FromLevel int
+	ToLevel   int
+	XP        int // XP still needed
+	Badges    int // Badge levels to craft
+	Cards     int // Trading cards needed to craft the badges
+	Cost      int // In the same minor units as the card price
+}
+
+// Estimates what it will take to reach a target level by crafting game badges.
+// cardsPerSet and cardPrice are averages across the games that will be crafted,
+// availableBadges is how many badge levels can still be crafted, or 0 if there is no limit.
+func EstimateToLevel(xp int, target int, cardsPerSet int, cardPrice int, availableBadges int) (estimate Estimate) {
+
+	estimate.FromLevel = LevelForXP(xp)
+	estimate.ToLevel = target
+	estimate.XP = XPToLevel(xp, target)
+
+	if estimate.XP == 0 {
+		estimate.ToLevel = estimate.FromLevel
+		return estimate
+	}
+
+	estimate.Badges = (estimate.XP + XPPerBadgeLevel - 1) / XPPerBadgeLevel
+
+	if availableBadges > 0 && estimate.Badges > availableBadges {
+		estimate.Badges = availableBadges
+		estimate.ToLevel = LevelForXP(xp + availableBadges*XPPerBadgeLevel)
+	}
+
+	estimate.Cards = estimate.Badges * cardsPerSet
+	estimate.Cost = estimate.Cards * cardPrice
+
+	return estimate
+}
+
+// Returns the badge levels that can still be crafted for games, given the level each game's badge is at
+func AvailableBadges(badgeLevels []int) (available int) {
+
+	for _, level := range badgeLevels {
+		if level < MaxBadgeLevel {
+			available += MaxBadgeLevel - level
+		}
+	}
+	return available
+}
@@ -0,0 +1,112 @@
+package steamlevel
+
+// Every level in a block of ten costs the same amount of XP,
+// each block costs 100 XP per level more than the last.
+const (
+	levelsPerBlock  = 10
+	xpPerBlockLevel = 100
+
+	// XP for each level of a game badge, including the foil badge
+	XPPerBadgeLevel = 100
+
+	// Game badges have five normal levels and one foil level
+	MaxBadgeLevel = 5
+)
+
+// Returns the XP needed to go from level-1 to level
+func XPForLevelUp(level int) int {
+
+	if level <= 0 {
+		return 0
+	}
+
+	return ((level-1)/levelsPerBlock + 1) * xpPerBlockLevel
+}
+
+// Returns the total XP needed to reach a level
+func XPForLevel(level int) int {
+
+	if level <= 0 {
+		return 0
+	}
+
+	blocks := level / levelsPerBlock
+	remainder := level % levelsPerBlock
+
+	full := xpPerBlockLevel * levelsPerBlock * blocks * (blocks + 1) / 2
+	partial := xpPerBlockLevel * remainder * (blocks + 1)
+
+	return full + partial
+}
+
+// Returns the level a player with this much XP is on
+func LevelForXP(xp int) (level int) {
+
+	if xp <= 0 {
+		return 0
+	}
+
+	// Skip whole blocks first
+	for {
+		next := XPForLevel(level + levelsPerBlock)
+		if next > xp {
+			break
+		}
+		level += levelsPerBlock
+	}
+
+	for XPForLevel(level+1) <= xp {
+		level++
+	}
+
+	return level
+}
+
+// Returns the XP still needed to reach the next level
+func XPToNextLevel(xp int) int {
+
+	if xp < 0 {
+		xp = 0
+	}
+
+	return XPForLevel(LevelForXP(xp)+1) - xp
+}
+
+// Returns the XP still needed to reach a target level, 0 if it has already been reached
+func XPToLevel(xp int, target int) int {
+
+	needed := XPForLevel(target) - xp
+	if needed < 0 {
+		return 0
+	}
+	return needed
+}
+
+// Returns how far through the current level a player is, from 0 to 100
+func PercentOfLevel(xp int) int {
+
+	if xp <= 0 {
+		return 0
+	}
+
+	level := LevelForXP(xp)
+	start := XPForLevel(level)
+	finish := XPForLevel(level + 1)
+
+	return int(float64(xp-start) / float64(finish-start) * 100)
+}
+
+// Returns the XP given by a game badge at a level, foil badges are worth the same as one normal level
+func BadgeXP(level int, foil bool) (xp int) {
+
+	if level > MaxBadgeLevel {
+		level = MaxBadgeLevel
+	}
+	if level > 0 {
+		xp = level * XPPerBadgeLevel
+	}
+	if foil {
+		xp += XPPerBadgeLevel
+	}
+	return xp
+}
@@ -0,0 +1,91 @@
+package steamlevel
+
+import (
+	"testing"
+)
+
+func TestXPForLevel(t *testing.T) {
+
+	m := map[int]int{
+		0:   0,
+		1:   100,
+		10:  1000,
+		11:  1200,
+		20:  3000,
+		21:  3300,
+		50:  15000,
+		100: 55000,
+	}
+
+	for level, xp := range m {
+		if XPForLevel(level) != xp {
+			t.Error("XPForLevel", level, XPForLevel(level), xp)
+		}
+		if LevelForXP(xp) != level {
+			t.Error("LevelForXP", xp, LevelForXP(xp), level)
+		}
+	}
+
+	for level := 1; level < 500; level++ {
+		if XPForLevel(level)-XPForLevel(level-1) != XPForLevelUp(level) {
+			t.Error("XPForLevelUp", level)
+		}
+		if LevelForXP(XPForLevel(level)-1) != level-1 {
+			t.Error("LevelForXP", level)
+		}
+	}
+}
+
+// Values taken from IPlayerService/GetBadges responses
+func TestBadgesInfo(t *testing.T) {
+
+	infos := []struct {
+		PlayerXP                   int
+		PlayerLevel                int
+		PlayerXPNeededToLevelUp    int
+		PlayerXPNeededCurrentLevel int
+	}{
+		{PlayerXP: 5350, PlayerLevel: 27, PlayerXPNeededToLevelUp: 50, PlayerXPNeededCurrentLevel: 5100},
+		{PlayerXP: 1200, PlayerLevel: 11, PlayerXPNeededToLevelUp: 200, PlayerXPNeededCurrentLevel: 1200},
+		{PlayerXP: 62, PlayerLevel: 0, PlayerXPNeededToLevelUp: 38, PlayerXPNeededCurrentLevel: 0},
+	}
+
+	for _, info := range infos {
+
+		if LevelForXP(info.PlayerXP) != info.PlayerLevel {
+			t.Error("level", info.PlayerXP, LevelForXP(info.PlayerXP))
+		}
+		if XPToNextLevel(info.PlayerXP) != info.PlayerXPNeededToLevelUp {
+			t.Error("to level up", info.PlayerXP, XPToNextLevel(info.PlayerXP))
+		}
+		if XPForLevel(info.PlayerLevel) != info.PlayerXPNeededCurrentLevel {
+			t.Error("current level", info.PlayerXP, XPForLevel(info.PlayerLevel))
+		}
+	}
+
+	if PercentOfLevel(5350) != 83 {
+		t.Error("percent", PercentOfLevel(5350))
+	}
+}
+
+func TestEstimateToLevel(t *testing.T) {
+
+	e := EstimateToLevel(5350, 30, 8, 5, 0)
+	if e.FromLevel != 27 || e.ToLevel != 30 || e.XP != 650 || e.Badges != 7 || e.Cards != 56 || e.Cost != 280 {
+		t.Error("unlimited", e)
+	}
+
+	e = EstimateToLevel(5350, 30, 8, 5, AvailableBadges([]int{5, 4, 2}))
+	if e.Badges != 4 || e.ToLevel != 29 {
+		t.Error("limited", e)
+	}
+
+	e = EstimateToLevel(5350, 10, 8, 5, 0)
+	if e.XP != 0 || e.Badges != 0 || e.ToLevel != 27 {
+		t.Error("reached", e)
+	}
+
+	if BadgeXP(5, true) != 600 || BadgeXP(7, false) != 500 || BadgeXP(0, true) != 100 {
+		t.Error("badge xp")
+	}
+}